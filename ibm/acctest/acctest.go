@@ -181,9 +181,14 @@ var (
 	IcdDbDeploymentId         string
 	IcdDbBackupId             string
 	IcdDbTaskId               string
+	IcdDbRestoreRegion        string
+	IcdDbBackupEncryptionKey  string
 	KmsInstanceID             string
 	CrkID                     string
 	KmsAccountID              string
+	KmsDeletedKeyID           string
+	KmsDualAuthKeyID          string
+	KmsPurgeableKeyID         string
 	BaasEncryptionkeyCRN      string
 )
 
@@ -965,6 +970,17 @@ func init() {
 		IcdDbTaskId = "crn:v1:bluemix:public:databases-for-redis:au-syd:a/40ddc34a953a8c02f10987b59085b60e:367b0a22-05bb-41e3-a1ed-ded1ff0889e5:task:882013a6-2751-4df7-a77a-98d258638704"
 		fmt.Println("[INFO] Set the environment variable ICD_DB_TASK_ID for testing ibm_cloud_databases else it is set to default value 'crn:v1:bluemix:public:databases-for-redis:au-syd:a/40ddc34a953a8c02f10987b59085b60e:367b0a22-05bb-41e3-a1ed-ded1ff0889e5:task:882013a6-2751-4df7-a77a-98d258638704'")
 	}
+
+	IcdDbRestoreRegion = os.Getenv("ICD_DB_RESTORE_REGION")
+	if IcdDbRestoreRegion == "" {
+		IcdDbRestoreRegion = "us-east"
+		fmt.Println("[INFO] Set the environment variable ICD_DB_RESTORE_REGION for testing cross-region ibm_database restores else it is set to default value 'us-east'")
+	}
+
+	IcdDbBackupEncryptionKey = os.Getenv("ICD_DB_BACKUP_ENCRYPTION_KEY_CRN")
+	if IcdDbBackupEncryptionKey == "" {
+		fmt.Println("[INFO] Set the environment variable ICD_DB_BACKUP_ENCRYPTION_KEY_CRN for testing cross-region ibm_database restores, the tests are skipped if this is not set")
+	}
 	// Added for Power Colo Testing
 	Pi_image = os.Getenv("PI_IMAGE")
 	if Pi_image == "" {
@@ -1494,6 +1510,21 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IBM_KMS_ACCOUNT_ID for ibm_container_vpc_cluster resource or datasource else tests will fail if this is not set correctly")
 	}
 
+	KmsDeletedKeyID = os.Getenv("IBM_KMS_DELETED_KEY_ID")
+	if KmsDeletedKeyID == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_KMS_DELETED_KEY_ID with a deleted key of IBM_KMS_INSTANCE_ID for testing ibm_kms_key_restore resource else tests will fail if this is not set correctly")
+	}
+
+	KmsPurgeableKeyID = os.Getenv("IBM_KMS_PURGEABLE_KEY_ID")
+	if KmsPurgeableKeyID == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_KMS_PURGEABLE_KEY_ID with a key of IBM_KMS_INSTANCE_ID that was deleted more than 4 hours ago for testing ibm_kms_key_purge resource, the tests are skipped if this is not set")
+	}
+
+	KmsDualAuthKeyID = os.Getenv("IBM_KMS_DUAL_AUTH_KEY_ID")
+	if KmsDualAuthKeyID == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_KMS_DUAL_AUTH_KEY_ID with a key of IBM_KMS_INSTANCE_ID that has a dual authorization delete policy for testing ibm_kms_key_dual_auth_delete_approval resource else tests will fail if this is not set correctly")
+	}
+
 	IksClusterID = os.Getenv("IBM_CLUSTER_ID")
 	if IksClusterID == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_CLUSTER_ID for ibm_container_vpc_worker_pool resource or datasource else tests will fail if this is not set correctly")
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var zoneRegexp = regexp.MustCompile(`^([a-z]+-[a-z]+)-[0-9]+$`)
+
+// CRN is a Cloud Resource Name split into its segments.
+// The format is crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+type CRN struct {
+	Scheme          string
+	Version         string
+	CName           string
+	CType           string
+	ServiceName     string
+	Region          string
+	ScopeType       string
+	Scope           string
+	ServiceInstance string
+	ResourceType    string
+	Resource        string
+}
+
+// Parse splits a CRN into its segments. An empty string parses to an empty CRN, any other string
+// needs all ten segments so that a malformed CRN is not mistaken for a CRN without a location.
+func Parse(s string) (CRN, error) {
+	if s == "" {
+		return CRN{}, nil
+	}
+
+	segments := strings.Split(s, crnSeparator)
+	if len(segments) != 10 {
+		return CRN{}, fmt.Errorf("%w: %s has %d segments, expected 10", ErrMalformedCRN, s, len(segments))
+	}
+	if segments[0] != crn {
+		return CRN{}, fmt.Errorf("%w: %s does not start with %s", ErrMalformedCRN, s, crn)
+	}
+
+	crn := CRN{
+		Scheme:          segments[0],
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Region:          segments[5],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}
+
+	scopeSegments := segments[6]
+	if scopeSegments != "" {
+		if scopeSegments == "global" {
+			crn.Scope = "global"
+		} else {
+			scopeParts := strings.Split(scopeSegments, scopeSeparator)
+			if len(scopeParts) == 2 {
+				crn.ScopeType, crn.Scope = scopeParts[0], scopeParts[1]
+			} else {
+				return CRN{}, ErrMalformedScope
+			}
+		}
+	}
+
+	return crn, nil
+}
+
+// String joins the segments back into a CRN. Scheme and Version default to crn and v1.
+func (c CRN) String() string {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = crn
+	}
+	version := c.Version
+	if version == "" {
+		version = "v1"
+	}
+	scope := c.Scope
+	if c.ScopeType != "" {
+		scope = c.ScopeType + scopeSeparator + c.Scope
+	}
+	return strings.Join([]string{
+		scheme,
+		version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
+// Location returns the location of the CRN, prefixed with the cloud name outside of the public and staging clouds.
+func (c CRN) Location() string {
+	if c.CName == "bluemix" || c.CName == "staging" {
+		return c.Region
+	}
+	return c.CName + "-" + c.Region
+}
+
+// RegionFromZone returns the region of a multizone region zone, for example us-south for us-south-1.
+func RegionFromZone(zone string) (string, error) {
+	match := zoneRegexp.FindStringSubmatch(zone)
+	if match == nil {
+		return "", fmt.Errorf("The given zone %s is not a multizone region zone of the form <region>-<number>", zone)
+	}
+	return match[1], nil
+}
+
+// ParseCompositeID splits an ID made of count parts joined by separator.
+func ParseCompositeID(id, separator string, count int) ([]string, error) {
+	parts, err := SepIdParts(id, separator)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != count {
+		return nil, fmt.Errorf("The given id %s has %d parts separated by %s, expected %d", id, len(parts), separator, count)
+	}
+	return parts, nil
+}
+
+// IsPrivateEndpoint reports whether an endpoint URL points to a private or direct service endpoint.
+func IsPrivateEndpoint(endpoint string) bool {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return strings.HasPrefix(host, "private.") || strings.HasPrefix(host, "direct.") || strings.Contains(host, ".private.")
+}
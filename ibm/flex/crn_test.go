@@ -0,0 +1,109 @@
+package flex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCRN(t *testing.T) {
+	c, err := Parse("crn:v1:bluemix:public:kms:us-south:a/4448261269a14562b839e0a3019ed980:c1ad8d38-0ac5-4d22-9f4b-5ae3b4b9b1ca:key:6fc6a2f0-30c4-4b6e-a4dd-5b6f4e8f3e0d")
+	assert.NoError(t, err)
+	assert.Equal(t, "bluemix", c.CName)
+	assert.Equal(t, "public", c.CType)
+	assert.Equal(t, "kms", c.ServiceName)
+	assert.Equal(t, "us-south", c.Region)
+	assert.Equal(t, "a", c.ScopeType)
+	assert.Equal(t, "4448261269a14562b839e0a3019ed980", c.Scope)
+	assert.Equal(t, "c1ad8d38-0ac5-4d22-9f4b-5ae3b4b9b1ca", c.ServiceInstance)
+	assert.Equal(t, "key", c.ResourceType)
+	assert.Equal(t, "6fc6a2f0-30c4-4b6e-a4dd-5b6f4e8f3e0d", c.Resource)
+
+	c, err = Parse("crn:v1:bluemix:public:iam-identity::global::apikey:ApiKey-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "global", c.Scope)
+	assert.Equal(t, "", c.ScopeType)
+
+	c, err = Parse("")
+	assert.NoError(t, err)
+	assert.Equal(t, CRN{}, c)
+
+	_, err = Parse("crn:v1:bluemix:public:kms")
+	assert.ErrorIs(t, err, ErrMalformedCRN)
+
+	// the location segment is there but the CRN is still incomplete
+	c, err = Parse("crn:v1:bluemix:public:kms:us-south")
+	assert.EqualError(t, err, "malformed CRN: crn:v1:bluemix:public:kms:us-south has 6 segments, expected 10")
+	assert.Equal(t, "", c.Region)
+
+	_, err = Parse("crn:v1:bluemix:public:kms:us-south:a/1:2:key:3:extra")
+	assert.ErrorIs(t, err, ErrMalformedCRN)
+
+	_, err = Parse("arn:v1:bluemix:public:kms:us-south:a/1:2:key:3")
+	assert.ErrorIs(t, err, ErrMalformedCRN)
+
+	_, err = Parse("crn:v1:bluemix:public:kms:us-south:a/1/2:3:key:4")
+	assert.Equal(t, ErrMalformedScope, err)
+}
+
+func TestCRNString(t *testing.T) {
+	for _, s := range []string{
+		"crn:v1:bluemix:public:kms:us-south:a/4448261269a14562b839e0a3019ed980:c1ad8d38-0ac5-4d22-9f4b-5ae3b4b9b1ca::",
+		"crn:v1:bluemix:public:iam-identity::global::apikey:ApiKey-1",
+		"crn:v1:staging:public:cloud-object-storage:global:a/1234:5678:bucket:my-bucket",
+	} {
+		c, err := Parse(s)
+		assert.NoError(t, err)
+		assert.Equal(t, s, c.String())
+	}
+
+	c := CRN{CName: "bluemix", CType: "public", ServiceName: "secrets-manager", Region: "eu-de", ScopeType: "a", Scope: "1234", ServiceInstance: "5678"}
+	assert.Equal(t, "crn:v1:bluemix:public:secrets-manager:eu-de:a/1234:5678::", c.String())
+}
+
+func TestCRNLocation(t *testing.T) {
+	assert.Equal(t, "us-south", CRN{CName: "bluemix", Region: "us-south"}.Location())
+	assert.Equal(t, "us-south", CRN{CName: "staging", Region: "us-south"}.Location())
+	assert.Equal(t, "ys1-eu-gb", CRN{CName: "ys1", Region: "eu-gb"}.Location())
+}
+
+func TestRegionFromZone(t *testing.T) {
+	region, err := RegionFromZone("us-south-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "us-south", region)
+
+	region, err = RegionFromZone("eu-de-3")
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-de", region)
+
+	for _, zone := range []string{"", "dal10", "us-south", "us-south-a"} {
+		_, err = RegionFromZone(zone)
+		assert.Error(t, err, zone)
+	}
+}
+
+func TestParseCompositeID(t *testing.T) {
+	parts, err := ParseCompositeID("cluster/pool", "/", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cluster", "pool"}, parts)
+
+	parts, err = ParseCompositeID("a:b:c", ":", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, parts)
+
+	_, err = ParseCompositeID("a/b/c", "/", 2)
+	assert.Error(t, err)
+
+	_, err = ParseCompositeID("abc", "/", 2)
+	assert.Error(t, err)
+}
+
+func TestIsPrivateEndpoint(t *testing.T) {
+	assert.True(t, IsPrivateEndpoint("https://private.us-south.iaas.cloud.ibm.com"))
+	assert.True(t, IsPrivateEndpoint("private.us-south.kms.cloud.ibm.com"))
+	assert.True(t, IsPrivateEndpoint("https://direct.us-south.cloud-object-storage.appdomain.cloud"))
+	assert.True(t, IsPrivateEndpoint("https://1234.private.us-south.secrets-manager.appdomain.cloud"))
+	assert.False(t, IsPrivateEndpoint("https://us-south.iaas.cloud.ibm.com"))
+	assert.False(t, IsPrivateEndpoint("https://iam.cloud.ibm.com"))
+	assert.False(t, IsPrivateEndpoint(""))
+}
@@ -2266,70 +2266,12 @@ func GetLocation(instance models.ServiceInstanceV2) string {
 	}
 }
 
-type CRN struct {
-	Scheme          string
-	Version         string
-	CName           string
-	CType           string
-	ServiceName     string
-	Region          string
-	ScopeType       string
-	Scope           string
-	ServiceInstance string
-	ResourceType    string
-	Resource        string
-}
-
-func Parse(s string) (CRN, error) {
-	if s == "" {
-		return CRN{}, nil
-	}
-
-	segments := strings.Split(s, crnSeparator)
-	if len(segments) != 10 || segments[0] != crn {
-		return CRN{}, ErrMalformedCRN
-	}
-
-	crn := CRN{
-		Scheme:          segments[0],
-		Version:         segments[1],
-		CName:           segments[2],
-		CType:           segments[3],
-		ServiceName:     segments[4],
-		Region:          segments[5],
-		ServiceInstance: segments[7],
-		ResourceType:    segments[8],
-		Resource:        segments[9],
-	}
-
-	scopeSegments := segments[6]
-	if scopeSegments != "" {
-		if scopeSegments == "global" {
-			crn.Scope = "global"
-		} else {
-			scopeParts := strings.Split(scopeSegments, scopeSeparator)
-			if len(scopeParts) == 2 {
-				crn.ScopeType, crn.Scope = scopeParts[0], scopeParts[1]
-			} else {
-				return CRN{}, ErrMalformedScope
-			}
-		}
-	}
-
-	return crn, nil
-}
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
 		log.Fatal(err)
 	}
-	region := crn.Region
-	cName := crn.CName
-	if cName == "bluemix" || cName == "staging" {
-		return region
-	} else {
-		return cName + "-" + region
-	}
+	return crn.Location()
 }
 
 func GetTags(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The drift statuses of a target account of an IAM template assignment, from the least to the most severe
+const (
+	TemplateAssignmentInSync   = "in_sync"
+	TemplateAssignmentUnknown  = "unknown"
+	TemplateAssignmentFailed   = "failed"
+	TemplateAssignmentModified = "modified"
+	TemplateAssignmentMissing  = "missing"
+)
+
+var templateAssignmentDriftSeverity = map[string]int{
+	TemplateAssignmentInSync:   0,
+	TemplateAssignmentUnknown:  1,
+	TemplateAssignmentFailed:   2,
+	TemplateAssignmentModified: 3,
+	TemplateAssignmentMissing:  4,
+}
+
+// TemplateAssignmentDrift is the drift of a target account from the template version of an assignment
+type TemplateAssignmentDrift struct {
+	AccountID string
+	Status    string
+	Reasons   []string
+}
+
+// Remediable reports whether running the assignment again can bring the account back to the template
+func (drift TemplateAssignmentDrift) Remediable() bool {
+	return drift.Status == TemplateAssignmentFailed || drift.Status == TemplateAssignmentModified || drift.Status == TemplateAssignmentMissing
+}
+
+// TemplateAssignmentDriftReport collects the drift of the target accounts of an assignment, an account has the most
+// severe status reported for it and all the reasons
+type TemplateAssignmentDriftReport struct {
+	accounts []string
+	drift    map[string]*TemplateAssignmentDrift
+}
+
+func NewTemplateAssignmentDriftReport() *TemplateAssignmentDriftReport {
+	return &TemplateAssignmentDriftReport{drift: map[string]*TemplateAssignmentDrift{}}
+}
+
+func (report *TemplateAssignmentDriftReport) Add(accountID, status, reason string) {
+	drift, ok := report.drift[accountID]
+	if !ok {
+		drift = &TemplateAssignmentDrift{AccountID: accountID, Status: TemplateAssignmentInSync, Reasons: []string{}}
+		report.drift[accountID] = drift
+		report.accounts = append(report.accounts, accountID)
+	}
+	if templateAssignmentDriftSeverity[status] > templateAssignmentDriftSeverity[drift.Status] {
+		drift.Status = status
+	}
+	if reason != "" {
+		drift.Reasons = append(drift.Reasons, reason)
+	}
+}
+
+func (report *TemplateAssignmentDriftReport) List() []TemplateAssignmentDrift {
+	list := make([]TemplateAssignmentDrift, 0, len(report.accounts))
+	for _, accountID := range report.accounts {
+		list = append(list, *report.drift[accountID])
+	}
+	return list
+}
+
+// AddTemplateAssignmentDriftFields adds the drift detection and remediation fields to the schema of a template
+// assignment resource
+func AddTemplateAssignmentDriftFields(resource *schema.Resource) *schema.Resource {
+	resource.Schema["auto_remediate"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the assignment is run again on the target accounts that drifted from the template.",
+	}
+	resource.Schema["drift"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The drift of each target account from the template version of the assignment.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the target account.",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The drift status of the account, one of `in_sync`, `unknown`, `failed`, `modified` or `missing`.",
+				},
+				"reasons": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "What differs from the template in the account.",
+				},
+			},
+		},
+	}
+	resource.Schema["drifted_accounts"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The IDs of the target accounts that failed, were modified or miss resources of the template.",
+	}
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, templateAssignmentDriftCustomizeDiff)
+	} else {
+		resource.CustomizeDiff = templateAssignmentDriftCustomizeDiff
+	}
+	return resource
+}
+
+// templateAssignmentDriftCustomizeDiff plans an update to remediate the drifted accounts
+func templateAssignmentDriftCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("auto_remediate").(bool) || len(diff.Get("drifted_accounts").([]interface{})) == 0 {
+		return nil
+	}
+	if err := diff.SetNewComputed("drift"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("drifted_accounts")
+}
+
+// TemplateAssignmentNeedsRemediation reports whether an update has to run the assignment again on drifted accounts
+func TemplateAssignmentNeedsRemediation(d *schema.ResourceData) bool {
+	if !d.Get("auto_remediate").(bool) {
+		return false
+	}
+	// The drifted accounts are unknown in the plan when they are remediated
+	o, _ := d.GetChange("drifted_accounts")
+	return len(o.([]interface{})) > 0
+}
+
+func SetTemplateAssignmentDrift(d *schema.ResourceData, drift []TemplateAssignmentDrift) error {
+	list := []map[string]interface{}{}
+	drifted := []string{}
+	for _, account := range drift {
+		list = append(list, map[string]interface{}{
+			"account_id": account.AccountID,
+			"status":     account.Status,
+			"reasons":    account.Reasons,
+		})
+		if account.Remediable() {
+			drifted = append(drifted, account.AccountID)
+		}
+	}
+	if err := d.Set("drift", list); err != nil {
+		return fmt.Errorf("[ERROR] Error setting drift: %s", err)
+	}
+	if err := d.Set("drifted_accounts", drifted); err != nil {
+		return fmt.Errorf("[ERROR] Error setting drifted_accounts: %s", err)
+	}
+	return nil
+}
+
+// TemplateAssignmentDriftDiagnostics returns an error for each account that still drifts after a remediation
+func TemplateAssignmentDriftDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, a := range d.Get("drift").([]interface{}) {
+		m, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		account := TemplateAssignmentDrift{AccountID: m["account_id"].(string), Status: m["status"].(string)}
+		if !account.Remediable() {
+			continue
+		}
+		for _, reason := range m["reasons"].([]interface{}) {
+			account.Reasons = append(account.Reasons, reason.(string))
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("[ERROR] Assignment %s could not remediate account %s, its status is %s", d.Id(), account.AccountID, account.Status),
+			Detail:   strings.Join(account.Reasons, "\n"),
+		})
+	}
+	return diags
+}
+
+// WaitForTemplateAssignmentSettled waits until an assignment is no longer accepted or in progress, whatever its outcome,
+// so that the outcome can be reported for each target account
+func WaitForTemplateAssignmentSettled(context context.Context, timeout time.Duration, getStatus func() (string, error)) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"accepted", "in_progress"},
+		Target:  []string{"settled"},
+		Refresh: func() (interface{}, string, error) {
+			status, err := getStatus()
+			if err != nil {
+				return nil, "", err
+			}
+			if status == "accepted" || status == "in_progress" {
+				return status, status, nil
+			}
+			return status, "settled", nil
+		},
+		Delay:        30 * time.Second,
+		PollInterval: time.Minute,
+		Timeout:      timeout,
+	}
+	_, err := stateConf.WaitForStateContext(context)
+	return err
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateAssignmentDriftReport(t *testing.T) {
+	report := NewTemplateAssignmentDriftReport()
+	report.Add("account-b", TemplateAssignmentInSync, "")
+	report.Add("account-a", TemplateAssignmentInSync, "")
+	report.Add("account-a", TemplateAssignmentMissing, "access group deleted")
+	report.Add("account-a", TemplateAssignmentFailed, "rule failed")
+	report.Add("account-c", TemplateAssignmentUnknown, "forbidden")
+
+	assert.Equal(t, []TemplateAssignmentDrift{
+		{AccountID: "account-b", Status: TemplateAssignmentInSync, Reasons: []string{}},
+		{AccountID: "account-a", Status: TemplateAssignmentMissing, Reasons: []string{"access group deleted", "rule failed"}},
+		{AccountID: "account-c", Status: TemplateAssignmentUnknown, Reasons: []string{"forbidden"}},
+	}, report.List())
+}
+
+func TestTemplateAssignmentDriftDiagnostics(t *testing.T) {
+	resource := AddTemplateAssignmentDriftFields(&schema.Resource{Schema: map[string]*schema.Schema{}})
+	d := resource.TestResourceData()
+	d.SetId("assignment-1")
+
+	report := NewTemplateAssignmentDriftReport()
+	report.Add("account-a", TemplateAssignmentInSync, "")
+	report.Add("account-b", TemplateAssignmentModified, "mfa is \"NONE\", the template sets \"TOTP\"")
+	report.Add("account-c", TemplateAssignmentUnknown, "forbidden")
+	report.Add("account-d", TemplateAssignmentFailed, "rule failed")
+	assert.NoError(t, SetTemplateAssignmentDrift(d, report.List()))
+
+	assert.Equal(t, []interface{}{"account-b", "account-d"}, d.Get("drifted_accounts"))
+	diags := TemplateAssignmentDriftDiagnostics(d)
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, diag.Error, diags[0].Severity)
+		assert.Contains(t, diags[0].Summary, "account-b")
+		assert.Equal(t, "mfa is \"NONE\", the template sets \"TOTP\"", diags[0].Detail)
+		assert.Contains(t, diags[1].Summary, "account-d")
+	}
+}
@@ -361,6 +361,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_template_assignment":     iamaccessgroup.DataSourceIBMIAMAccessGroupTemplateAssignment(),
 			"ibm_iam_account_settings":                     iamidentity.DataSourceIBMIAMAccountSettings(),
 			"ibm_iam_auth_token":                           iamidentity.DataSourceIBMIAMAuthToken(),
+			"ibm_iam_access_check":                         iampolicy.DataSourceIBMIAMAccessCheck(),
 			"ibm_iam_role_actions":                         iampolicy.DataSourceIBMIAMRoleAction(),
 			"ibm_iam_users":                                iamidentity.DataSourceIBMIAMUsers(),
 			"ibm_iam_roles":                                iampolicy.DataSourceIBMIAMRole(),
@@ -566,6 +567,7 @@ func Provider() *schema.Provider {
 			"ibm_kp_key":                             kms.DataSourceIBMkey(),
 			"ibm_kms_key_rings":                      kms.DataSourceIBMKMSkeyRings(),
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
+			"ibm_kms_key_registrations":              kms.DataSourceIBMKMSKeyRegistrations(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
 			"ibm_pn_application_chrome":              pushnotification.DataSourceIBMPNApplicationChrome(),
@@ -582,15 +584,16 @@ func Provider() *schema.Provider {
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
-			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
-			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
-			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
-			"ibm_resource_key":      resourcecontroller.DataSourceIBMResourceKey(),
-			"ibm_security_group":    classicinfrastructure.DataSourceIBMSecurityGroup(),
-			"ibm_service_instance":  cloudfoundry.DataSourceIBMServiceInstance(),
-			"ibm_service_key":       cloudfoundry.DataSourceIBMServiceKey(),
-			"ibm_service_plan":      cloudfoundry.DataSourceIBMServicePlan(),
-			"ibm_space":             cloudfoundry.DataSourceIBMSpace(),
+			"ibm_resource_quota":          resourcecontroller.DataSourceIBMResourceQuota(),
+			"ibm_resource_group":          resourcemanager.DataSourceIBMResourceGroup(),
+			"ibm_resource_group_contents": resourcemanager.DataSourceIBMResourceGroupContents(),
+			"ibm_resource_instance":       resourcecontroller.DataSourceIBMResourceInstance(),
+			"ibm_resource_key":            resourcecontroller.DataSourceIBMResourceKey(),
+			"ibm_security_group":          classicinfrastructure.DataSourceIBMSecurityGroup(),
+			"ibm_service_instance":        cloudfoundry.DataSourceIBMServiceInstance(),
+			"ibm_service_key":             cloudfoundry.DataSourceIBMServiceKey(),
+			"ibm_service_plan":            cloudfoundry.DataSourceIBMServicePlan(),
+			"ibm_space":                   cloudfoundry.DataSourceIBMSpace(),
 
 			// Added for Schematics
 			"ibm_schematics_workspace":      schematics.DataSourceIBMSchematicsWorkspace(),
@@ -763,7 +766,8 @@ func Provider() *schema.Provider {
 			"ibm_cm_object":            catalogmanagement.DataSourceIBMCmObject(),
 
 			// Added for Resource Tag
-			"ibm_resource_tag": globaltagging.DataSourceIBMResourceTag(),
+			"ibm_resource_tag":    globaltagging.DataSourceIBMResourceTag(),
+			"ibm_resource_search": globaltagging.DataSourceIBMResourceSearch(),
 
 			// Atracker
 			"ibm_atracker_targets": atracker.DataSourceIBMAtrackerTargets(),
@@ -813,8 +817,9 @@ func Provider() *schema.Provider {
 			"ibm_pag_instance": pag.DataSourceIBMPag(),
 
 			// Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":        contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":        contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_report": contextbasedrestrictions.DataSourceIBMCbrRuleReport(),
 
 			// Added for Event Notifications
 			"ibm_en_source":                    eventnotification.DataSourceIBMEnSource(),
@@ -977,6 +982,12 @@ func Provider() *schema.Provider {
 
 			"ibm_cis":                            cis.ResourceIBMCISInstance(),
 			"ibm_database":                       database.ResourceIBMDatabaseInstance(),
+			"ibm_database_user":                  database.ResourceIBMDatabaseUser(),
+			"ibm_database_allowlist_entry":       database.ResourceIBMDatabaseAllowlistEntry(),
+			"ibm_database_configuration":         database.ResourceIBMDatabaseConfiguration(),
+			"ibm_database_autoscaling":           database.ResourceIBMDatabaseAutoscaling(),
+			"ibm_database_backup":                database.ResourceIBMDatabaseBackup(),
+			"ibm_database_replica_promotion":     database.ResourceIBMDatabaseReplicaPromotion(),
 			"ibm_cis_domain":                     cis.ResourceIBMCISDomain(),
 			"ibm_cis_domain_settings":            cis.ResourceIBMCISSettings(),
 			"ibm_cis_firewall":                   cis.ResourceIBMCISFirewallRecord(),
@@ -1081,6 +1092,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_custom_role":                          iampolicy.ResourceIBMIAMCustomRole(),
 			"ibm_iam_access_group_dynamic_rule":            iamaccessgroup.ResourceIBMIAMDynamicRule(),
 			"ibm_iam_access_group_members":                 iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
+			"ibm_iam_access_group_membership_sync":         iamaccessgroup.ResourceIBMIAMAccessGroupMembershipSync(),
 			"ibm_iam_access_group_policy":                  iampolicy.ResourceIBMIAMAccessGroupPolicy(),
 			"ibm_iam_authorization_policy":                 iampolicy.ResourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":          iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
@@ -1213,6 +1225,10 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                             kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                             kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                          kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_key_rotate":                            kms.ResourceIBMKmsKeyRotate(),
+			"ibm_kms_key_restore":                           kms.ResourceIBMKmsKeyRestore(),
+			"ibm_kms_key_purge":                             kms.ResourceIBMKmsKeyPurge(),
+			"ibm_kms_key_dual_auth_delete_approval":         kms.ResourceIBMKmsKeyDualAuthDeleteApproval(),
 			"ibm_kp_key":                                    kms.ResourceIBMkey(),
 			"ibm_kms_instance_policies":                     kms.ResourceIBMKmsInstancePolicy(),
 			"ibm_resource_group":                            resourcemanager.ResourceIBMResourceGroup(),
@@ -1250,6 +1266,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
 			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
 			"ibm_pi_snapshot":                        power.ResourceIBMPISnapshot(),
+			"ibm_pi_snapshot_restore":                power.ResourceIBMPISnapshotRestore(),
 			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 			"ibm_pi_volume_attach":                   power.ResourceIBMPIVolumeAttach(),
 			"ibm_pi_volume_clone":                    power.ResourceIBMPIVolumeClone(),
@@ -1298,9 +1315,10 @@ func Provider() *schema.Provider {
 			"ibm_cm_object":            catalogmanagement.ResourceIBMCmObject(),
 
 			// Added for enterprise
-			"ibm_enterprise":               enterprise.ResourceIBMEnterprise(),
-			"ibm_enterprise_account_group": enterprise.ResourceIBMEnterpriseAccountGroup(),
-			"ibm_enterprise_account":       enterprise.ResourceIBMEnterpriseAccount(),
+			"ibm_enterprise":                 enterprise.ResourceIBMEnterprise(),
+			"ibm_enterprise_account_group":   enterprise.ResourceIBMEnterpriseAccountGroup(),
+			"ibm_enterprise_account":         enterprise.ResourceIBMEnterpriseAccount(),
+			"ibm_enterprise_account_factory": enterprise.ResourceIBMEnterpriseAccountFactory(),
 
 			// //Added for Usage Reports
 			"ibm_billing_report_snapshot": usagereports.ResourceIBMBillingReportSnapshot(),
@@ -1336,12 +1354,15 @@ func Provider() *schema.Provider {
 			"ibm_sm_iam_credentials_configuration":                               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmIamCredentialsConfiguration()),
 			"ibm_sm_public_certificate_action_validate_manual_dns":               secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificateActionValidateManualDns()),
 			"ibm_sm_en_registration":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmEnRegistration()),
+			"ibm_sm_secret_version_rotate":                                       secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretVersionRotate()),
+			"ibm_sm_rotation_policy":                                             secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmRotationPolicy()),
 			"ibm_sm_private_certificate_configuration_action_sign_csr":           secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSignCsr()),
 			"ibm_sm_private_certificate_configuration_action_set_signed":         secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPrivateCertificateConfigurationActionSetSigned()),
 
 			// satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
+			"ibm_satellite_host_assignment_policy":              satellite.ResourceIBMSatelliteHostAssignmentPolicy(),
 			"ibm_satellite_cluster":                             satellite.ResourceIBMSatelliteCluster(),
 			"ibm_satellite_cluster_worker_pool":                 satellite.ResourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.ResourceIBMSatelliteLink(),
@@ -1354,6 +1375,7 @@ func Provider() *schema.Provider {
 			// Added for Resource Tag
 			"ibm_resource_tag":        globaltagging.ResourceIBMResourceTag(),
 			"ibm_resource_access_tag": globaltagging.ResourceIBMResourceAccessTag(),
+			"ibm_resource_tag_bulk":   globaltagging.ResourceIBMResourceTagBulk(),
 
 			// Atracker
 			"ibm_atracker_target":   atracker.ResourceIBMAtrackerTarget(),
@@ -1718,6 +1740,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_dl_provider_gateway":                      directlink.ResourceIBMDLProviderGatewayValidator(),
 				"ibm_dl_gateway_action":                        directlink.ResourceIBMDLGatewayActionValidator(),
 				"ibm_database":                                 database.ResourceIBMICDValidator(),
+				"ibm_database_user":                            database.ResourceIBMDatabaseUserValidator(),
 				"ibm_function_package":                         functions.ResourceIBMFuncPackageValidator(),
 				"ibm_function_action":                          functions.ResourceIBMFuncActionValidator(),
 				"ibm_function_rule":                            functions.ResourceIBMFuncRuleValidator(),
@@ -1812,6 +1835,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_virtual_endpoint_gateway":         vpc.ResourceIBMISEndpointGatewayValidator(),
 				"ibm_resource_tag":                        globaltagging.ResourceIBMResourceTagValidator(),
 				"ibm_resource_access_tag":                 globaltagging.ResourceIBMResourceAccessTagValidator(),
+				"ibm_resource_tag_bulk":                   globaltagging.ResourceIBMResourceTagBulkValidator(),
 				"ibm_satellite_location":                  satellite.ResourceIBMSatelliteLocationValidator(),
 				"ibm_satellite_cluster":                   satellite.ResourceIBMSatelliteClusterValidator(),
 				"ibm_pi_volume":                           power.ResourceIBMPIVolumeValidator(),
@@ -1880,6 +1904,7 @@ func Validator() validate.ValidatorDict {
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
 				"ibm_iam_access_group_members":             iamaccessgroup.ResourceIBMIAMAccessGroupMembersValidator(),
+				"ibm_iam_access_group_membership_sync":     iamaccessgroup.ResourceIBMIAMAccessGroupMembershipSyncValidator(),
 				"ibm_iam_access_group_template":            iamaccessgroup.ResourceIBMIAMAccessGroupTemplateValidator(),
 				"ibm_iam_access_group_template_version":    iamaccessgroup.ResourceIBMIAMAccessGroupTemplateVersionValidator(),
 				"ibm_iam_access_group_template_assignment": iamaccessgroup.ResourceIBMIAMAccessGroupTemplateAssignmentValidator(),
@@ -1901,6 +1926,8 @@ func Validator() validate.ValidatorDict {
 				// // Added for Secrets Manager
 				"ibm_sm_secret_group":                                                secretsmanager.ResourceIbmSmSecretGroupValidator(),
 				"ibm_sm_en_registration":                                             secretsmanager.ResourceIbmSmEnRegistrationValidator(),
+				"ibm_sm_secret_version_rotate":                                       secretsmanager.ResourceIbmSmSecretVersionRotateValidator(),
+				"ibm_sm_rotation_policy":                                             secretsmanager.ResourceIbmSmRotationPolicyValidator(),
 				"ibm_sm_public_certificate_configuration_dns_cis":                    secretsmanager.ResourceIbmSmConfigurationPublicCertificateDNSCisValidator(),
 				"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructureValidator(),
 
@@ -2018,6 +2045,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_trusted_profile_claim_rules": iamidentity.DataSourceIBMIamTrustedProfileClaimRulesValidator(),
 				"ibm_iam_trusted_profiles":            iamidentity.DataSourceIBMIamTrustedProfilesValidator(),
 
+				"ibm_iam_access_check":           iampolicy.DataSourceIBMIAMAccessCheckValidator(),
 				"ibm_iam_access_group_policy":    iampolicy.DataSourceIBMIAMAccessGroupPolicyValidator(),
 				"ibm_iam_service_policy":         iampolicy.DataSourceIBMIAMServicePolicyValidator(),
 				"ibm_iam_trusted_profile_policy": iampolicy.DataSourceIBMIAMTrustedProfilePolicyValidator(),
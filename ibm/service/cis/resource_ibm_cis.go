@@ -296,10 +296,11 @@ func ResourceIBMCISInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_id", *instance.ResourceGroupID)
 	d.Set("parameters", flex.Flatten(instance.Parameters))
 	if instance.CRN != nil {
-		location := strings.Split(*instance.CRN, ":")
-		if len(location) > 5 {
-			d.Set("location", location[5])
+		crn, err := flex.Parse(*instance.CRN)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing the CRN %s: %s", *instance.CRN, err)
 		}
+		d.Set("location", crn.Region)
 	}
 	d.Set("guid", *instance.GUID)
 
@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Activity Tracker events can be exported as is or wrapped by IBM Cloud Logs, these are the keys of the wrapped event
+var cbrReportEventEnvelopes = []string{"user_data", "userData", "_source", "json"}
+
+var cbrReportEventTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.00-0700",
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05-0700",
+}
+
+type cbrReportOptions struct {
+	RuleID             string
+	From               *time.Time
+	To                 *time.Time
+	ExcludedPrincipals []string
+}
+
+// cbrReportDenial groups the would-be denials of a principal for an action on a resource from a source address
+type cbrReportDenial struct {
+	PrincipalID   string
+	PrincipalType string
+	Action        string
+	ResourceCRN   string
+	SourceIP      string
+	Excluded      bool
+	Count         int
+	FirstSeen     time.Time
+	LastSeen      time.Time
+}
+
+type cbrReport struct {
+	// EventCount is the number of events of the rule in the time window
+	EventCount          int
+	DeniedCount         int
+	ExcludedDeniedCount int
+	Denials             []*cbrReportDenial
+}
+
+// parseCbrReportEvents parses an Activity Tracker or IBM Cloud Logs export. The export is a JSON list of events, an
+// object with the events in an events or logs list, or one JSON event per line
+func parseCbrReportEvents(export string) ([]map[string]interface{}, error) {
+	export = strings.TrimSpace(export)
+	if export == "" {
+		return nil, nil
+	}
+
+	var raw []interface{}
+	switch {
+	case strings.HasPrefix(export, "["):
+		if err := json.Unmarshal([]byte(export), &raw); err != nil {
+			return nil, fmt.Errorf("Error parsing events export: %s", err)
+		}
+	default:
+		var doc map[string]interface{}
+		if err := json.Unmarshal([]byte(export), &doc); err == nil {
+			if list, ok := doc["events"].([]interface{}); ok {
+				raw = list
+			} else if list, ok := doc["logs"].([]interface{}); ok {
+				raw = list
+			} else {
+				raw = []interface{}{doc}
+			}
+			break
+		}
+		// One event per line, or a sequence of events
+		decoder := json.NewDecoder(strings.NewReader(export))
+		for {
+			var event interface{}
+			err := decoder.Decode(&event)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing events export at offset %d: %s", decoder.InputOffset(), err)
+			}
+			raw = append(raw, event)
+		}
+	}
+
+	events := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		event, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		events = append(events, unwrapCbrReportEvent(event))
+	}
+	return events, nil
+}
+
+func unwrapCbrReportEvent(event map[string]interface{}) map[string]interface{} {
+	if _, ok := event["responseData"]; ok {
+		return event
+	}
+	for _, key := range cbrReportEventEnvelopes {
+		if inner, ok := event[key].(map[string]interface{}); ok {
+			return unwrapCbrReportEvent(inner)
+		}
+		// Cloud Logs can keep the event as a JSON string
+		if text, ok := event[key].(string); ok {
+			var inner map[string]interface{}
+			if err := json.Unmarshal([]byte(text), &inner); err == nil {
+				return unwrapCbrReportEvent(inner)
+			}
+		}
+	}
+	return event
+}
+
+// analyzeCbrReportEvents summarises the would-be denials of a rule in report mode. An event belongs to the rule when
+// the rule ID appears in its response data, and it is a would-be denial when the decision is deny and the rule was not
+// enforced
+func analyzeCbrReportEvents(events []map[string]interface{}, options cbrReportOptions) cbrReport {
+	report := cbrReport{Denials: []*cbrReportDenial{}}
+	denials := map[string]*cbrReportDenial{}
+
+	for _, event := range events {
+		responseData, _ := event["responseData"].(map[string]interface{})
+		if responseData == nil || !cbrReportContains(responseData, options.RuleID) {
+			continue
+		}
+
+		eventTime, hasTime := cbrReportEventTime(event)
+		if (options.From != nil || options.To != nil) && !hasTime {
+			continue
+		}
+		if options.From != nil && eventTime.Before(*options.From) {
+			continue
+		}
+		if options.To != nil && eventTime.After(*options.To) {
+			continue
+		}
+		report.EventCount++
+
+		if !strings.EqualFold(cbrReportString(responseData, "decision"), "deny") {
+			continue
+		}
+		if enforced, ok := responseData["isEnforced"].(bool); (ok && enforced) || cbrReportString(responseData, "isEnforced") == "true" {
+			continue
+		}
+
+		denial := &cbrReportDenial{
+			PrincipalID:   cbrReportString(event, "initiator", "id"),
+			PrincipalType: cbrReportString(event, "initiator", "typeURI"),
+			Action:        cbrReportString(event, "requestData", "action"),
+			ResourceCRN:   cbrReportString(event, "target", "id"),
+			SourceIP:      cbrReportString(event, "initiator", "host", "address"),
+		}
+		if denial.Action == "" {
+			denial.Action = cbrReportString(event, "action")
+		}
+		if denial.SourceIP == "" {
+			denial.SourceIP = cbrReportString(event, "requestData", "environment", "attributes", "ipAddress")
+		}
+		denial.Excluded = cbrReportPrincipalExcluded(denial.PrincipalID, options.ExcludedPrincipals)
+
+		key := strings.Join([]string{denial.PrincipalID, denial.Action, denial.ResourceCRN, denial.SourceIP}, "|")
+		if existing, ok := denials[key]; ok {
+			denial = existing
+		} else {
+			denials[key] = denial
+			report.Denials = append(report.Denials, denial)
+		}
+		denial.Count++
+		if hasTime {
+			if denial.FirstSeen.IsZero() || eventTime.Before(denial.FirstSeen) {
+				denial.FirstSeen = eventTime
+			}
+			if eventTime.After(denial.LastSeen) {
+				denial.LastSeen = eventTime
+			}
+		}
+		if denial.Excluded {
+			report.ExcludedDeniedCount++
+		} else {
+			report.DeniedCount++
+		}
+	}
+
+	sort.SliceStable(report.Denials, func(i, j int) bool {
+		if report.Denials[i].Count != report.Denials[j].Count {
+			return report.Denials[i].Count > report.Denials[j].Count
+		}
+		return report.Denials[i].PrincipalID < report.Denials[j].PrincipalID
+	})
+	return report
+}
+
+func cbrReportEventTime(event map[string]interface{}) (time.Time, bool) {
+	value := cbrReportString(event, "eventTime")
+	if value == "" {
+		value = cbrReportString(event, "timestamp")
+	}
+	for _, layout := range cbrReportEventTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// cbrReportString returns the string at the given path of a JSON object
+func cbrReportString(object map[string]interface{}, path ...string) string {
+	var value interface{} = object
+	for _, key := range path {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		value = m[key]
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// cbrReportContains checks whether a value appears anywhere in a JSON value
+func cbrReportContains(value interface{}, s string) bool {
+	switch v := value.(type) {
+	case string:
+		return v == s
+	case map[string]interface{}:
+		for _, item := range v {
+			if cbrReportContains(item, s) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if cbrReportContains(item, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cbrReportPrincipalExcluded matches a principal against patterns where * matches any sequence of characters
+func cbrReportPrincipalExcluded(principal string, patterns []string) bool {
+	for _, pattern := range patterns {
+		expr := strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+		if matches, _ := regexp.MatchString("^"+expr+"$", principal); matches {
+			return true
+		}
+	}
+	return false
+}
+
+// cbrReportFromExports parses the exports and summarises the would-be denials of a rule
+func cbrReportFromExports(exports []string, options cbrReportOptions) (cbrReport, error) {
+	events := []map[string]interface{}{}
+	for i, export := range exports {
+		parsed, err := parseCbrReportEvents(export)
+		if err != nil {
+			return cbrReport{}, fmt.Errorf("Error in events export %d: %s", i+1, err)
+		}
+		events = append(events, parsed...)
+	}
+	return analyzeCbrReportEvents(events, options), nil
+}
+
+// cbrReportFromMap builds a report from the events, from, to and excluded_principals arguments shared by
+// ibm_cbr_rule_report and the promote_if_clean block of ibm_cbr_rule
+func cbrReportFromMap(ruleID string, modelMap map[string]interface{}) (cbrReport, error) {
+	options := cbrReportOptions{RuleID: ruleID}
+	for _, key := range []string{"from", "to"} {
+		value, _ := modelMap[key].(string)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return cbrReport{}, fmt.Errorf("Invalid %s %s: %s", key, value, err)
+		}
+		if key == "from" {
+			options.From = &t
+		} else {
+			options.To = &t
+		}
+	}
+	if principals, ok := modelMap["excluded_principals"].([]interface{}); ok {
+		for _, p := range principals {
+			if s, ok := p.(string); ok && s != "" {
+				options.ExcludedPrincipals = append(options.ExcludedPrincipals, s)
+			}
+		}
+	}
+	exports := []string{}
+	if events, ok := modelMap["events"].([]interface{}); ok {
+		for _, e := range events {
+			if s, ok := e.(string); ok {
+				exports = append(exports, s)
+			}
+		}
+	}
+	return cbrReportFromExports(exports, options)
+}
+
+// cbrReportArgumentsSchema returns the arguments that select the events of a report
+func cbrReportArgumentsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"events": &schema.Schema{
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The contents of Activity Tracker or IBM Cloud Logs exports of the events of the rule, as a JSON list of events or one JSON event per line.",
+		},
+		"from": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "The start of the time window, in RFC 3339 format.",
+		},
+		"to": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "The end of the time window, in RFC 3339 format.",
+		},
+		"excluded_principals": &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The IAM IDs of the principals whose denials are expected, `*` matches any sequence of characters.",
+		},
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCbrReportRuleID = "bf2c1d4d1a0e3d2a9f8c3c5e6d7b8a90"
+
+func testCbrReportEvent(eventTime, principal, decision string, enforced bool) string {
+	return `{"eventTime": "` + eventTime + `", "action": "containers-kubernetes.cluster.read",
+		"initiator": {"id": "` + principal + `", "typeURI": "service/security/account/user", "host": {"address": "203.0.113.10"}},
+		"target": {"id": "crn:v1:bluemix:public:containers-kubernetes:us-south:a/12ab34cd:cluster1::"},
+		"requestData": {"action": "containers-kubernetes.cluster.read"},
+		"responseData": {"decision": "` + decision + `", "isEnforced": ` + map[bool]string{true: "true", false: "false"}[enforced] + `,
+			"evaluatedRules": [{"ruleId": "` + testCbrReportRuleID + `"}]}}`
+}
+
+func TestParseCbrReportEvents(t *testing.T) {
+	lines := testCbrReportEvent("2024-03-01T10:00:00.00+0000", "IBMid-1", "Deny", false) + "\n\n" +
+		`{"user_data": ` + testCbrReportEvent("2024-03-01T11:00:00.00+0000", "IBMid-2", "Permit", false) + `}`
+	events, err := parseCbrReportEvents(lines)
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "IBMid-2", cbrReportString(events[1], "initiator", "id"))
+
+	events, err = parseCbrReportEvents(`[` + testCbrReportEvent("2024-03-01T10:00:00.00+0000", "IBMid-1", "Deny", false) + `]`)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+
+	events, err = parseCbrReportEvents(`{"logs": [{"json": ` + testCbrReportEvent("2024-03-01T10:00:00.00+0000", "IBMid-1", "Deny", false) + `}]}`)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "Deny", cbrReportString(events[0], "responseData", "decision"))
+
+	_, err = parseCbrReportEvents("{\"eventTime\": \n")
+	assert.NotNil(t, err)
+}
+
+func TestAnalyzeCbrReportEvents(t *testing.T) {
+	export := `[` +
+		testCbrReportEvent("2024-03-01T10:00:00.00+0000", "IBMid-1", "Deny", false) + `,` +
+		testCbrReportEvent("2024-03-01T12:00:00.00+0000", "IBMid-1", "Deny", false) + `,` +
+		testCbrReportEvent("2024-03-01T11:00:00.00+0000", "iam-ServiceId-backup", "Deny", false) + `,` +
+		testCbrReportEvent("2024-03-01T11:00:00.00+0000", "IBMid-2", "Permit", false) + `,` +
+		testCbrReportEvent("2024-03-01T11:00:00.00+0000", "IBMid-3", "Deny", true) + `,` +
+		testCbrReportEvent("2024-02-01T11:00:00.00+0000", "IBMid-4", "Deny", false) +
+		`]`
+	events, err := parseCbrReportEvents(export)
+	assert.Nil(t, err)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	report := analyzeCbrReportEvents(events, cbrReportOptions{
+		RuleID:             testCbrReportRuleID,
+		From:               &from,
+		ExcludedPrincipals: []string{"iam-ServiceId-*"},
+	})
+	assert.Equal(t, 5, report.EventCount)
+	assert.Equal(t, 2, report.DeniedCount)
+	assert.Equal(t, 1, report.ExcludedDeniedCount)
+	assert.Len(t, report.Denials, 2)
+	assert.Equal(t, "IBMid-1", report.Denials[0].PrincipalID)
+	assert.Equal(t, 2, report.Denials[0].Count)
+	assert.Equal(t, "203.0.113.10", report.Denials[0].SourceIP)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), report.Denials[0].FirstSeen.UTC())
+	assert.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), report.Denials[0].LastSeen.UTC())
+	assert.True(t, report.Denials[1].Excluded)
+
+	report = analyzeCbrReportEvents(events, cbrReportOptions{RuleID: "another-rule"})
+	assert.Equal(t, 0, report.EventCount)
+	assert.Equal(t, 0, report.DeniedCount)
+}
+
+func TestCbrReportFromMap(t *testing.T) {
+	report, err := cbrReportFromMap(testCbrReportRuleID, map[string]interface{}{
+		"events":              []interface{}{testCbrReportEvent("2024-03-01T10:00:00.00+0000", "IBMid-1", "Deny", false)},
+		"to":                  "2024-03-01T09:00:00Z",
+		"excluded_principals": []interface{}{},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, report.EventCount)
+
+	_, err = cbrReportFromMap(testCbrReportRuleID, map[string]interface{}{"from": "yesterday"})
+	assert.NotNil(t, err)
+}
+
+func TestResourceIBMCbrRulePromotionMode(t *testing.T) {
+	clean := testCbrReportEvent("2024-03-01T10:00:00.00+0000", "IBMid-1", "Permit", false)
+	denied := testCbrReportEvent("2024-03-01T11:00:00.00+0000", "IBMid-2", "Deny", false)
+
+	mode, diags := resourceIBMCbrRulePromotionMode(testCbrReportRuleID, map[string]interface{}{
+		"events": []interface{}{clean},
+	})
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 0)
+	assert.Equal(t, cbrRuleEnforcementModeEnabled, mode)
+
+	mode, diags = resourceIBMCbrRulePromotionMode(testCbrReportRuleID, map[string]interface{}{
+		"events": []interface{}{clean + "\n" + denied},
+	})
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, cbrRuleEnforcementModeReport, mode)
+
+	// Without events of the rule there is nothing to show that the rule is clean
+	mode, diags = resourceIBMCbrRulePromotionMode(testCbrReportRuleID, map[string]interface{}{
+		"events": []interface{}{""},
+	})
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, cbrRuleEnforcementModeReport, mode)
+}
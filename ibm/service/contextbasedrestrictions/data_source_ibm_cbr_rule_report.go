@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+func DataSourceIBMCbrRuleReport() *schema.Resource {
+	reportSchema := cbrReportArgumentsSchema()
+	reportSchema["rule_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The ID of a rule.",
+	}
+	reportSchema["enforcement_mode"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The current enforcement mode of the rule.",
+	}
+	reportSchema["event_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of events of the rule in the time window.",
+	}
+	reportSchema["denied_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of requests of principals that are not excluded that would be denied if the rule was enforced.",
+	}
+	reportSchema["excluded_denied_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of requests of excluded principals that would be denied if the rule was enforced.",
+	}
+	reportSchema["clean"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether no request of a principal that is not excluded would be denied.",
+	}
+	reportSchema["denials"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The requests that would be denied, grouped by principal, action, resource and source IP address, most frequent first.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"principal_id": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The IAM ID of the principal.",
+				},
+				"principal_type": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the principal.",
+				},
+				"action": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The action of the request.",
+				},
+				"resource_crn": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The CRN of the target resource.",
+				},
+				"source_ip": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The IP address the requests came from.",
+				},
+				"excluded": &schema.Schema{
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the principal is excluded.",
+				},
+				"count": &schema.Schema{
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of requests.",
+				},
+				"first_seen": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time of the first request.",
+				},
+				"last_seen": &schema.Schema{
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The time of the last request.",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleReportRead,
+		Schema:      reportSchema,
+	}
+}
+
+func dataSourceIBMCbrRuleReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getRuleOptions := &contextbasedrestrictionsv1.GetRuleOptions{}
+
+	getRuleOptions.SetRuleID(d.Get("rule_id").(string))
+
+	rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetRuleWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetRuleWithContext failed %s\n%s", err, response))
+	}
+
+	report, err := cbrReportFromMap(*getRuleOptions.RuleID, map[string]interface{}{
+		"events":              d.Get("events"),
+		"from":                d.Get("from"),
+		"to":                  d.Get("to"),
+		"excluded_principals": d.Get("excluded_principals"),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*getRuleOptions.RuleID)
+
+	if err = d.Set("enforcement_mode", rule.EnforcementMode); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enforcement_mode: %s", err))
+	}
+	if err = d.Set("event_count", report.EventCount); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_count: %s", err))
+	}
+	if err = d.Set("denied_count", report.DeniedCount); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting denied_count: %s", err))
+	}
+	if err = d.Set("excluded_denied_count", report.ExcludedDeniedCount); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting excluded_denied_count: %s", err))
+	}
+	if err = d.Set("clean", report.DeniedCount == 0); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting clean: %s", err))
+	}
+	denials := []map[string]interface{}{}
+	for _, denial := range report.Denials {
+		denials = append(denials, dataSourceIBMCbrRuleReportDenialToMap(denial))
+	}
+	if err = d.Set("denials", denials); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting denials: %s", err))
+	}
+
+	if rule.EnforcementMode != nil && *rule.EnforcementMode != "report" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Rule %s is in %s mode", *getRuleOptions.RuleID, *rule.EnforcementMode),
+			Detail:   "Would-be denials are only reported while the rule is in report mode, the denials of the rule while it is enforced are not counted.",
+		}}
+	}
+	return nil
+}
+
+func dataSourceIBMCbrRuleReportDenialToMap(denial *cbrReportDenial) map[string]interface{} {
+	modelMap := map[string]interface{}{
+		"principal_id":   denial.PrincipalID,
+		"principal_type": denial.PrincipalType,
+		"action":         denial.Action,
+		"resource_crn":   denial.ResourceCRN,
+		"source_ip":      denial.SourceIP,
+		"excluded":       denial.Excluded,
+		"count":          denial.Count,
+	}
+	if !denial.FirstSeen.IsZero() {
+		modelMap["first_seen"] = denial.FirstSeen.Format(time.RFC3339)
+		modelMap["last_seen"] = denial.LastSeen.Format(time.RFC3339)
+	}
+	return modelMap
+}
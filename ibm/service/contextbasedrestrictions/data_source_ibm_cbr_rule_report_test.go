@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrRuleReportDataSourceBasic(t *testing.T) {
+	accountID, _ := getTestAccountAndZoneID()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCbr(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleReportDataSourceConfig(accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_rule_report.cbr_rule_report", "id", "ibm_cbr_rule.cbr_rule", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "enforcement_mode", "report"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "event_count", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "denied_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "excluded_denied_count", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "clean", "false"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "denials.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "denials.0.principal_id", "IBMid-550000AAAA"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_report.cbr_rule_report", "denials.0.source_ip", "203.0.113.10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleReportDataSourceConfig(accountID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Zone Data Source Config Basic"
+			description = "Test Zone Data Source Config Basic"
+			account_id = "%s"
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+		}
+
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "Test Rule Report Data Source"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = "%s"
+				}
+				attributes {
+					name = "serviceName"
+					value = "containers-kubernetes"
+				}
+			}
+			enforcement_mode = "report"
+		}
+
+		locals {
+			events = [
+				for e in [
+					{ principal = "IBMid-550000AAAA", decision = "Deny" },
+					{ principal = "iam-ServiceId-00000000-0000-0000-0000-000000000000", decision = "Deny" },
+					{ principal = "IBMid-550000BBBB", decision = "Permit" },
+				] : {
+					eventTime = "2024-03-01T10:00:00.00+0000"
+					action    = "containers-kubernetes.cluster.read"
+					initiator = { id = e.principal, typeURI = "service/security/account/user", host = { address = "203.0.113.10" } }
+					target    = { id = "crn:v1:bluemix:public:containers-kubernetes:us-south:a/%s:cluster::" }
+					requestData  = { action = "containers-kubernetes.cluster.read" }
+					responseData = { decision = e.decision, isEnforced = false, evaluatedRules = [{ ruleId = ibm_cbr_rule.cbr_rule.id }] }
+				}
+			]
+		}
+
+		data "ibm_cbr_rule_report" "cbr_rule_report" {
+			rule_id             = ibm_cbr_rule.cbr_rule.id
+			events              = [jsonencode(local.events)]
+			excluded_principals = ["iam-ServiceId-*"]
+		}
+	`, accountID, accountID, accountID)
+}
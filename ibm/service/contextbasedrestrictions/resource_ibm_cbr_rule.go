@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +25,9 @@ const (
 	cbrRuleReadPending  = "pending"
 	cbrRuleReadComplete = "finished"
 	cbrRuleReadError    = "error"
+
+	cbrRuleEnforcementModeEnabled = "enabled"
+	cbrRuleEnforcementModeReport  = "report"
 )
 
 func ResourceIBMCbrRule() *schema.Resource {
@@ -163,6 +167,15 @@ func ResourceIBMCbrRule() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
 				Description:  "The rule enforcement mode: * `enabled` - The restrictions are enforced and reported. This is the default. * `disabled` - The restrictions are disabled. Nothing is enforced or reported. * `report` - The restrictions are evaluated and reported, but not enforced.",
 			},
+			"promote_if_clean": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Only switch the rule from report to enabled when the report-mode events show no would-be denials of principals that are not excluded. Until then, the rule stays in report mode.",
+				Elem: &schema.Resource{
+					Schema: cbrReportArgumentsSchema(),
+				},
+			},
 			"x_correlation_id": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -344,8 +357,19 @@ func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, m
 		}
 		createRuleOptions.SetOperations(operationsModel)
 	}
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk("enforcement_mode"); ok {
-		createRuleOptions.SetEnforcementMode(d.Get("enforcement_mode").(string))
+		enforcementMode := d.Get("enforcement_mode").(string)
+		if _, ok := d.GetOk("promote_if_clean"); ok && enforcementMode == cbrRuleEnforcementModeEnabled {
+			// A new rule has no report-mode events yet
+			enforcementMode = cbrRuleEnforcementModeReport
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The rule is created in report mode",
+				Detail:   "promote_if_clean is set, the rule is switched to enabled once its report-mode events show no would-be denials.",
+			})
+		}
+		createRuleOptions.SetEnforcementMode(enforcementMode)
 	}
 	if _, ok := d.GetOk("x_correlation_id"); ok {
 		createRuleOptions.SetXCorrelationID(d.Get("x_correlation_id").(string))
@@ -365,7 +389,7 @@ func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, m
 	// handle Eventual consistency case
 	readNewCbrRule(contextBasedRestrictionsClient, context, d)
 
-	return resourceIBMCbrRuleRead(context, d, meta)
+	return append(diags, resourceIBMCbrRuleRead(context, d, meta)...)
 }
 
 func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -503,8 +527,29 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 		}
 		replaceRuleOptions.SetOperations(operations)
 	}
+	var diags diag.Diagnostics
 	if _, ok := d.GetOk("enforcement_mode"); ok {
-		replaceRuleOptions.SetEnforcementMode(d.Get("enforcement_mode").(string))
+		enforcementMode := d.Get("enforcement_mode").(string)
+		if v, ok := d.GetOk("promote_if_clean.0"); ok && enforcementMode == cbrRuleEnforcementModeEnabled && d.HasChange("enforcement_mode") {
+			oldMode, _ := d.GetChange("enforcement_mode")
+			if oldMode.(string) == cbrRuleEnforcementModeReport {
+				var promoteDiags diag.Diagnostics
+				enforcementMode, promoteDiags = resourceIBMCbrRulePromotionMode(d.Id(), v.(map[string]interface{}))
+				diags = append(diags, promoteDiags...)
+				if promoteDiags.HasError() {
+					return diags
+				}
+			} else {
+				// The rule has to be evaluated in report mode before it is promoted
+				enforcementMode = cbrRuleEnforcementModeReport
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Rule %s is switched to report mode", d.Id()),
+					Detail:   "promote_if_clean is set, the rule is switched to enabled once its report-mode events show no would-be denials.",
+				})
+			}
+		}
+		replaceRuleOptions.SetEnforcementMode(enforcementMode)
 	}
 	if _, ok := d.GetOk("x_correlation_id"); ok {
 		replaceRuleOptions.SetXCorrelationID(d.Get("x_correlation_id").(string))
@@ -520,7 +565,37 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 		return diag.FromErr(fmt.Errorf("ReplaceRuleWithContext failed %s\n%s", err, response))
 	}
 
-	return resourceIBMCbrRuleRead(context, d, meta)
+	return append(diags, resourceIBMCbrRuleRead(context, d, meta)...)
+}
+
+// resourceIBMCbrRulePromotionMode returns enabled when the report-mode events of the rule show no would-be denials of
+// principals that are not excluded, and report otherwise or when there are no events of the rule
+func resourceIBMCbrRulePromotionMode(ruleID string, promoteIfClean map[string]interface{}) (string, diag.Diagnostics) {
+	report, err := cbrReportFromMap(ruleID, promoteIfClean)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	if report.DeniedCount > 0 {
+		principals := []string{}
+		for _, denial := range report.Denials {
+			if !denial.Excluded && len(principals) < 5 {
+				principals = append(principals, fmt.Sprintf("%s (%s, %d)", denial.PrincipalID, denial.Action, denial.Count))
+			}
+		}
+		return cbrRuleEnforcementModeReport, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Rule %s stays in report mode", ruleID),
+			Detail:   fmt.Sprintf("%d requests would be denied if the rule was enforced, including %s. Fix the contexts of the rule or exclude the principals and apply again.", report.DeniedCount, strings.Join(principals, ", ")),
+		}}
+	}
+	if report.EventCount == 0 {
+		return cbrRuleEnforcementModeReport, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Rule %s stays in report mode", ruleID),
+			Detail:   "No report-mode events of the rule were found in the events of promote_if_clean, so it cannot be checked that the rule would not deny requests. Export the events of the rule after it was evaluated in report mode and apply again.",
+		}}
+	}
+	return cbrRuleEnforcementModeEnabled, nil
 }
 
 func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,6 +6,7 @@ package contextbasedrestrictions_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -73,6 +74,52 @@ func TestAccIBMCbrRuleAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCbrRulePromoteIfClean(t *testing.T) {
+	var ruleID string
+	eventsFile := filepath.Join(t.TempDir(), "events.json")
+	writeEvents := func(decision string) {
+		event := fmt.Sprintf(`{"eventTime": "2024-03-01T10:00:00.00+0000", "initiator": {"id": "IBMid-550000AAAA"}, "responseData": {"decision": "%s", "isEnforced": false, "ruleId": "%s"}}`, decision, ruleID)
+		if err := os.WriteFile(eventsFile, []byte(event), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	accountID, _ := getTestAccountAndZoneID()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCbr(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCbrRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleConfig("tf_promote_if_clean", "report", accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_rule.cbr_rule", "enforcement_mode", "report"),
+					func(s *terraform.State) error {
+						ruleID = s.RootModule().Resources["ibm_cbr_rule.cbr_rule"].Primary.ID
+						writeEvents("Deny")
+						return nil
+					},
+				),
+			},
+			resource.TestStep{
+				// A would-be denial keeps the rule in report mode
+				Config:             testAccCheckIBMCbrRuleConfigPromoteIfClean(eventsFile, accountID),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_rule.cbr_rule", "enforcement_mode", "report"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() { writeEvents("Permit") },
+				Config:    testAccCheckIBMCbrRuleConfigPromoteIfClean(eventsFile, accountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_rule.cbr_rule", "enforcement_mode", "enabled"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCbrRuleConfigBasic(accountID string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cbr_zone" "cbr_zone" {
@@ -256,3 +303,51 @@ func testAccCheckIBMCbrRuleDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMCbrRuleConfigPromoteIfClean(eventsFile string, accountID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Zone Data Source Config Basic"
+			description = "Test Zone Data Source Config Basic"
+			account_id = "%s"
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+		}
+
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "tf_promote_if_clean"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = "%s"
+				}
+				attributes {
+					name = "serviceName"
+					value = "containers-kubernetes"
+				}
+				tags {
+					name = "name"
+					value = "value"
+					operator = "stringEquals"
+				}
+			}
+			operations {
+				api_types {
+					api_type_id = "crn:v1:bluemix:public:containers-kubernetes::::api-type:management"
+				}
+			}
+			enforcement_mode = "enabled"
+			promote_if_clean {
+				events = [file("%s")]
+			}
+		}
+	`, accountID, accountID, eventsFile)
+}
@@ -80,16 +80,24 @@ func dataSourceIBMDatabaseRemotesRead(context context.Context, d *schema.Resourc
 
 	d.SetId(d.Get("deployment_id").(string))
 
-	if remotes.Remotes.Leader != nil {
-		if err = d.Set("leader", remotes.Remotes.Leader); err != nil {
-			return diag.FromErr(fmt.Errorf("Error setting leader: %s", err))
+	// A promoted replica no longer reports a leader, so both are always set to reflect the current relationship
+	leader := ""
+	replicas := []string{}
+	if remotes.Remotes != nil {
+		if remotes.Remotes.Leader != nil {
+			leader = *remotes.Remotes.Leader
+		}
+		if remotes.Remotes.Replicas != nil {
+			replicas = remotes.Remotes.Replicas
 		}
 	}
 
-	if remotes.Remotes.Replicas != nil {
-		if err = d.Set("replicas", remotes.Remotes.Replicas); err != nil {
-			return diag.FromErr(fmt.Errorf("Error setting replicas: %s", err))
-		}
+	if err = d.Set("leader", leader); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting leader: %s", err))
+	}
+
+	if err = d.Set("replicas", replicas); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting replicas: %s", err))
 	}
 
 	return nil
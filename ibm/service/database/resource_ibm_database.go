@@ -44,6 +44,8 @@ const (
 	databaseInstanceFailStatus         = "failed"
 	databaseInstanceRemovedStatus      = "removed"
 	databaseInstanceReclamation        = "pending_reclamation"
+	databaseInstanceRestoringStatus    = "restoring"
+	databaseInstanceRunningStatus      = "running"
 )
 
 const (
@@ -130,6 +132,21 @@ func retry(f func() error) (err error) {
 	}
 }
 
+// splitDatabaseID splits an ID of the form <deployment_crn>/<suffix>. The deployment CRN contains a "/" in its
+// scope segment, so the ID is split at the first "/" after the last CRN segment separator.
+func splitDatabaseID(id string) (instanceID string, suffix string, err error) {
+	separators := 0
+	for i, c := range id {
+		if c == ':' {
+			separators++
+		}
+		if c == '/' && separators == 9 {
+			return id[:i], id[i+1:], nil
+		}
+	}
+	return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of the database CRN and the sub-resource ID separated by /", id)
+}
+
 func ResourceIBMDatabaseInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMDatabaseInstanceCreate,
@@ -241,11 +258,16 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Description: "The configuration schema in JSON format",
 			},
 			"version": {
-				Description: "The database version to provision if specified",
+				Description: "The database version to provision if specified. Changing it upgrades the deployment in place to the new major version.",
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"version_upgrade_skip_backup": {
+				Description: "Skip the on-demand backup taken before an in-place version upgrade",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
 			},
 			"service_endpoints": {
 				Description:  "Types of the service endpoints. Possible values are 'public', 'private', 'public-and-private'.",
@@ -738,65 +760,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"capacity_enabled": {
-										Description: "Auto Scaling Scalar: Capacity Enabled",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Computed:    true,
-									},
-									"free_space_less_than_percent": {
-										Description: "Auto Scaling Scalar: Capacity Free Space Less Than Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"io_enabled": {
-										Description: "Auto Scaling Scalar: IO Utilization Enabled",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Computed:    true,
-									},
-
-									"io_over_period": {
-										Description: "Auto Scaling Scalar: IO Utilization Over Period",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-									"io_above_percent": {
-										Description: "Auto Scaling Scalar: IO Utilization Above Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_increase_percent": {
-										Description: "Auto Scaling Rate: Increase Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_period_seconds": {
-										Description: "Auto Scaling Rate: Period Seconds",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_limit_mb_per_member": {
-										Description: "Auto Scaling Rate: Limit mb per member",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_units": {
-										Description: "Auto Scaling Rate: Units ",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-								},
-							},
+							Elem:        resourceIBMDatabaseAutoscalingDiskSchema(),
 						},
 						"memory": {
 							Type:        schema.TypeList,
@@ -804,53 +768,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"io_enabled": {
-										Description: "Auto Scaling Scalar: IO Utilization Enabled",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Computed:    true,
-									},
-
-									"io_over_period": {
-										Description: "Auto Scaling Scalar: IO Utilization Over Period",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-									"io_above_percent": {
-										Description: "Auto Scaling Scalar: IO Utilization Above Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_increase_percent": {
-										Description: "Auto Scaling Rate: Increase Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_period_seconds": {
-										Description: "Auto Scaling Rate: Period Seconds",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_limit_mb_per_member": {
-										Description: "Auto Scaling Rate: Limit mb per member",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_units": {
-										Description: "Auto Scaling Rate: Units ",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-								},
-							},
+							Elem:        resourceIBMDatabaseAutoscalingMemorySchema(),
 						},
 						"cpu": {
 							Type:        schema.TypeList,
@@ -922,6 +840,119 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 		},
 	}
 }
+
+func resourceIBMDatabaseAutoscalingDiskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"capacity_enabled": {
+				Description: "Auto Scaling Scalar: Capacity Enabled",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"free_space_less_than_percent": {
+				Description: "Auto Scaling Scalar: Capacity Free Space Less Than Percent",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"io_enabled": {
+				Description: "Auto Scaling Scalar: IO Utilization Enabled",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"io_over_period": {
+				Description: "Auto Scaling Scalar: IO Utilization Over Period",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"io_above_percent": {
+				Description: "Auto Scaling Scalar: IO Utilization Above Percent",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_increase_percent": {
+				Description: "Auto Scaling Rate: Increase Percent",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_period_seconds": {
+				Description: "Auto Scaling Rate: Period Seconds",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_limit_mb_per_member": {
+				Description: "Auto Scaling Rate: Limit mb per member",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_units": {
+				Description: "Auto Scaling Rate: Units ",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseAutoscalingMemorySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"io_enabled": {
+				Description: "Auto Scaling Scalar: IO Utilization Enabled",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"io_over_period": {
+				Description: "Auto Scaling Scalar: IO Utilization Over Period",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"io_above_percent": {
+				Description: "Auto Scaling Scalar: IO Utilization Above Percent",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_increase_percent": {
+				Description: "Auto Scaling Rate: Increase Percent",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_period_seconds": {
+				Description: "Auto Scaling Rate: Period Seconds",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_limit_mb_per_member": {
+				Description: "Auto Scaling Rate: Limit mb per member",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rate_units": {
+				Description: "Auto Scaling Rate: Units ",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
 func ResourceIBMICDValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -998,6 +1029,7 @@ type Params struct {
 	PITRDeploymentID    string  `json:"point_in_time_recovery_deployment_id,omitempty"`
 	PITRTimeStamp       *string `json:"point_in_time_recovery_time,omitempty"`
 	OfflineRestore      bool    `json:"offline_restore,omitempty"`
+	SkipBackup          bool    `json:"skip_backup,omitempty"`
 }
 
 type Group struct {
@@ -1133,58 +1165,206 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		return fmt.Errorf("[ERROR] logical_replication_slot is only supported for databases-for-postgresql")
 	}
 
-	configJSON, configOk := diff.GetOk("configuration")
+	if configJSON, ok := diff.GetOk("configuration"); ok {
+		if err = validateDatabaseConfiguration(service, configJSON.(string)); err != nil {
+			return err
+		}
+	}
 
-	if configOk {
-		var rawConfig map[string]json.RawMessage
-		err = json.Unmarshal([]byte(configJSON.(string)), &rawConfig)
-		if err != nil {
-			return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+	_, offlineRestoreOk := diff.GetOk("offline_restore")
+	if offlineRestoreOk && service != "databases-for-mongodb" && plan != "enterprise" {
+		return fmt.Errorf("[ERROR] offline_restore is only supported for databases-for-mongodb enterprise")
+	}
+
+	if diff.Id() != "" && diff.HasChange("version") && diff.NewValueKnown("version") {
+		oldVersion, newVersion := diff.GetChange("version")
+		if oldVersion.(string) != "" && newVersion.(string) != "" && oldVersion.(string) != newVersion.(string) {
+			err = validateDatabaseVersionUpgrade(service, oldVersion.(string), newVersion.(string), meta)
+			if err != nil {
+				return err
+			}
 		}
+	}
 
-		var unmarshalFn func(m map[string]json.RawMessage, result interface{}) (err error)
+	if diff.Id() == "" && diff.NewValueKnown("backup_id") && diff.NewValueKnown("backup_encryption_key_crn") {
+		if backupID, ok := diff.GetOk("backup_id"); ok {
+			err = validateDatabaseRestore(service, diff.Get("location").(string), backupID.(string), diff.Get("backup_encryption_key_crn").(string))
+			if err != nil {
+				return err
+			}
+		}
+	}
 
-		var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+	return nil
+}
 
-		switch service {
-		case "databases-for-postgresql":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
-		case "databases-for-enterprisedb":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
-		case "databases-for-redis":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRedisConfiguration
-		case "databases-for-mysql":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationMySQLConfiguration
-		case "messages-for-rabbitmq":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRabbitMqConfiguration
-		default:
-			return fmt.Errorf("[ERROR] configuration is not supported for %s", service)
-		}
-
-		err = core.UnmarshalModel(rawConfig, "", &configuration, unmarshalFn)
-		if err != nil {
-			return fmt.Errorf("[ERROR] configuration is invalid\n%s", err)
+// validateDatabaseVersionUpgrade checks that the deployables of the service allow an upgrade from oldVersion to newVersion
+func validateDatabaseVersionUpgrade(service string, oldVersion string, newVersion string, meta interface{}) error {
+	cmp, err := compareDatabaseVersions(oldVersion, newVersion)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return fmt.Errorf("[ERROR] %s cannot be downgraded from version %s to %s", service, oldVersion, newVersion)
+	}
+
+	re := regexp.MustCompile("(?:messages|databases)-for-([a-z]+)")
+	match := re.FindStringSubmatch(service)
+	if match == nil {
+		return fmt.Errorf("[ERROR] Error invalid service name: %s", service)
+	}
+
+	// the upgrade path is only a plan time hint, the API still rejects an invalid upgrade on apply
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		log.Printf("[WARN] Skipping the check of the %s version upgrade from %s to %s, error getting database client settings: %s", service, oldVersion, newVersion, err)
+		return nil
+	}
+
+	listDeployablesResponse, response, err := cloudDatabasesClient.ListDeployables(&clouddatabasesv5.ListDeployablesOptions{})
+	if err != nil {
+		log.Printf("[WARN] Skipping the check of the %s version upgrade from %s to %s, error listing database deployables: %s\n%s", service, oldVersion, newVersion, err, response)
+		return nil
+	}
+
+	return checkDatabaseVersionUpgrade(listDeployablesResponse.Deployables, match[1], oldVersion, newVersion)
+}
+
+// checkDatabaseVersionUpgrade looks for a transition from oldVersion to newVersion in the deployables of the application
+func checkDatabaseVersionUpgrade(deployables []clouddatabasesv5.Deployables, application string, oldVersion string, newVersion string) error {
+	targets := []string{}
+	for _, deployable := range deployables {
+		if deployable.Type == nil || *deployable.Type != application {
+			continue
+		}
+		for _, version := range deployable.Versions {
+			for _, transition := range version.Transitions {
+				if transition.FromVersion == nil || transition.ToVersion == nil || *transition.FromVersion != oldVersion {
+					continue
+				}
+				if *transition.ToVersion == newVersion {
+					return nil
+				}
+				targets = append(targets, *transition.ToVersion)
+			}
 		}
+	}
+
+	if len(targets) == 0 {
+		return fmt.Errorf("[ERROR] %s version %s has no in-place upgrade path to %s", application, oldVersion, newVersion)
+	}
 
-		b, _ := json.Marshal(configuration)
-		var result map[string]json.RawMessage
-		json.Unmarshal(b, &result)
+	sort.Slice(targets, func(i, j int) bool {
+		cmp, err := compareDatabaseVersions(targets[i], targets[j])
+		if err != nil {
+			return targets[i] < targets[j]
+		}
+		return cmp < 0
+	})
+	return fmt.Errorf("[ERROR] %s cannot be upgraded in place from version %s to %s, upgrade to one of %s first", application, oldVersion, newVersion, strings.Join(targets, ", "))
+}
 
-		invalidFields := []string{}
-		for k, _ := range rawConfig {
-			if _, ok := result[k]; !ok {
-				invalidFields = append(invalidFields, k)
+// compareDatabaseVersions compares two dotted versions such as 7.2 and 7.10 component by component, it returns -1, 0
+// or 1 when a is lower than, equal to or greater than b
+func compareDatabaseVersions(a string, b string) (int, error) {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		var err error
+		if i < len(aParts) {
+			if aPart, err = strconv.Atoi(aParts[i]); err != nil {
+				return 0, fmt.Errorf("invalid version: %s", a)
+			}
+		}
+		if i < len(bParts) {
+			if bPart, err = strconv.Atoi(bParts[i]); err != nil {
+				return 0, fmt.Errorf("invalid version: %s", b)
 			}
 		}
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// validateDatabaseRestore checks that the backup can be restored into a new deployment of the service in location.
+// A backup from another region is re-wrapped with the backup encryption key, which must then be a key of the target region.
+func validateDatabaseRestore(service string, location string, backupID string, backupEncryptionKeyCRN string) error {
+	backupCRN, err := flex.Parse(backupID)
+	if err != nil || backupCRN.ResourceType != "backup" {
+		return fmt.Errorf("[ERROR] backup_id %s is not a database backup CRN", backupID)
+	}
+
+	if backupCRN.ServiceName != service {
+		return fmt.Errorf("[ERROR] backup_id %s is a %s backup and cannot be restored to %s", backupID, backupCRN.ServiceName, service)
+	}
+
+	if backupCRN.Region == location || backupEncryptionKeyCRN == "" {
+		return nil
+	}
+
+	keyCRN, err := flex.Parse(backupEncryptionKeyCRN)
+	if err != nil || keyCRN.ResourceType != "key" || (keyCRN.ServiceName != "kms" && keyCRN.ServiceName != "hs-crypto") {
+		return fmt.Errorf("[ERROR] backup_encryption_key_crn %s is not a Key Protect or Hyper Protect Crypto Services key CRN", backupEncryptionKeyCRN)
+	}
+
+	if keyCRN.Region != location {
+		return fmt.Errorf("[ERROR] Restoring the %s backup %s to %s requires a backup_encryption_key_crn in %s, got a key in %s", backupCRN.Region, backupID, location, location, keyCRN.Region)
+	}
+
+	return nil
+}
+
+// validateDatabaseConfiguration checks that the configuration JSON only contains fields supported by the service
+func validateDatabaseConfiguration(service string, configJSON string) (err error) {
+	var rawConfig map[string]json.RawMessage
+	err = json.Unmarshal([]byte(configJSON), &rawConfig)
+	if err != nil {
+		return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+	}
+
+	var unmarshalFn func(m map[string]json.RawMessage, result interface{}) (err error)
+
+	var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+
+	switch service {
+	case "databases-for-postgresql":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
+	case "databases-for-enterprisedb":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
+	case "databases-for-redis":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRedisConfiguration
+	case "databases-for-mysql":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationMySQLConfiguration
+	case "messages-for-rabbitmq":
+		unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRabbitMqConfiguration
+	default:
+		return fmt.Errorf("[ERROR] configuration is not supported for %s", service)
+	}
 
-		if len(invalidFields) != 0 {
-			return fmt.Errorf("[ERROR] configuration contained invalid field(s): %s", invalidFields)
+	err = core.UnmarshalModel(rawConfig, "", &configuration, unmarshalFn)
+	if err != nil {
+		return fmt.Errorf("[ERROR] configuration is invalid\n%s", err)
+	}
+
+	b, _ := json.Marshal(configuration)
+	var result map[string]json.RawMessage
+	json.Unmarshal(b, &result)
+
+	invalidFields := []string{}
+	for k, _ := range rawConfig {
+		if _, ok := result[k]; !ok {
+			invalidFields = append(invalidFields, k)
 		}
 	}
 
-	_, offlineRestoreOk := diff.GetOk("offline_restore")
-	if offlineRestoreOk && service != "databases-for-mongodb" && plan != "enterprise" {
-		return fmt.Errorf("[ERROR] offline_restore is only supported for databases-for-mongodb enterprise")
+	if len(invalidFields) != 0 {
+		return fmt.Errorf("[ERROR] configuration contained invalid field(s): %s", invalidFields)
 	}
 
 	return nil
@@ -1350,6 +1530,15 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 				"[ERROR] Error waiting for create database instance (%s) to complete: %s", *instance.ID, err))
 	}
 
+	if _, ok := d.GetOk("backup_id"); ok {
+		_, err = waitForDatabaseRestore(d, meta, *instance.ID)
+		if err != nil {
+			return diag.FromErr(
+				fmt.Errorf(
+					"[ERROR] Error waiting for database instance (%s) restore to complete: %s", *instance.ID, err))
+		}
+	}
+
 	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
 	if err != nil {
 		return diag.FromErr(err)
@@ -1673,10 +1862,11 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	d.Set("status", *instance.State)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
 	if instance.CRN != nil {
-		location := strings.Split(*instance.CRN, ":")
-		if len(location) > 5 {
-			d.Set("location", location[5])
+		crn, err := flex.Parse(*instance.CRN)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error parsing the CRN %s: %s", *instance.CRN, err)
 		}
+		d.Set("location", crn.Region)
 	}
 	d.Set("guid", *instance.GUID)
 
@@ -1859,6 +2049,49 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("version") {
+		oldVersion, newVersion := d.GetChange("version")
+
+		if !d.Get("version_upgrade_skip_backup").(bool) {
+			backup, err := startDatabaseBackup(instanceID, d, meta, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error backing up database (%s) before the version upgrade: %s", instanceID, err))
+			}
+			log.Printf("[INFO] Database (%s) backed up to %s before upgrading from version %s to %s", instanceID, *backup.ID, oldVersion, newVersion)
+		}
+
+		// The backup is either taken above or skipped on request, so the upgrade does not take another one
+		params := Params{
+			Version:    newVersion.(string),
+			SkipBackup: true,
+		}
+		parameters, _ := json.Marshal(params)
+		var raw map[string]interface{}
+		json.Unmarshal(parameters, &raw)
+
+		upgradeReq := rc.UpdateResourceInstanceOptions{
+			ID:         &instanceID,
+			Parameters: raw,
+		}
+
+		_, response, err := rsConClient.UpdateResourceInstance(&upgradeReq)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error upgrading database (%s) from version %s to %s: %s %s", instanceID, oldVersion, newVersion, err, response))
+		}
+
+		_, err = waitForDatabaseInstanceUpdate(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for upgrade of database (%s) to complete: %s", instanceID, err))
+		}
+
+		err = waitForDatabaseTasksComplete(instanceID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for upgrade of database (%s) to version %s to complete: %s", instanceID, newVersion, err))
+		}
+	}
+
 	if d.HasChange("tags") {
 		oldList, newList := d.GetChange("tags")
 		err = flex.UpdateTagsUsingCRN(oldList, newList, meta, instanceID)
@@ -1880,37 +2113,10 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 	if d.HasChange("configuration") {
 		if config, ok := d.GetOk("configuration"); ok {
-			var rawConfig map[string]json.RawMessage
-			err = json.Unmarshal([]byte(config.(string)), &rawConfig)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err))
-			}
-
-			var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
-			err = core.UnmarshalModel(rawConfig, "", &configuration, clouddatabasesv5.UnmarshalConfiguration)
+			err = updateDatabaseConfiguration(instanceID, config.(string), d, meta)
 			if err != nil {
 				return diag.FromErr(err)
 			}
-
-			updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
-				ID:            &instanceID,
-				Configuration: configuration,
-			}
-
-			updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
-
-			if err != nil {
-				return diag.FromErr(fmt.Errorf(
-					"[ERROR] Error updating database configuration failed %s\n%s", err, response))
-			}
-
-			taskID := *updateDatabaseConfigurationResponse.Task.ID
-
-			_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
-			if err != nil {
-				return diag.FromErr(fmt.Errorf(
-					"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", icdId, err))
-			}
 		}
 	}
 
@@ -2227,6 +2433,47 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
+// updateDatabaseConfiguration applies the configuration JSON to the deployment and waits for the task to complete
+func updateDatabaseConfiguration(instanceID string, configJSON string, d *schema.ResourceData, meta interface{}) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	var rawConfig map[string]json.RawMessage
+	err = json.Unmarshal([]byte(configJSON), &rawConfig)
+	if err != nil {
+		return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+	}
+
+	var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+	err = core.UnmarshalModel(rawConfig, "", &configuration, clouddatabasesv5.UnmarshalConfiguration)
+	if err != nil {
+		return err
+	}
+
+	updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
+		ID:            &instanceID,
+		Configuration: configuration,
+	}
+
+	updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfiguration(updateDatabaseConfigurationOptions)
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error updating database configuration failed %s\n%s", err, response)
+	}
+
+	taskID := *updateDatabaseConfigurationResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", flex.EscapeUrlParm(instanceID), err)
+	}
+
+	return nil
+}
+
 func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint string, meta interface{}) (flex.CsEntry, error) {
 	csEntry := flex.CsEntry{}
 	icdClient, err := meta.(conns.ClientSession).ICDAPI()
@@ -2429,6 +2676,47 @@ func waitForDatabaseInstanceCreate(d *schema.ResourceData, meta interface{}, ins
 	return stateConf.WaitForState()
 }
 
+// waitForDatabaseRestore waits until a deployment restored from a backup has no queued or running tasks left
+func waitForDatabaseRestore(d *schema.ResourceData, meta interface{}, instanceID string) (interface{}, error) {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return false, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{databaseInstanceRestoringStatus},
+		Target:  []string{databaseInstanceRunningStatus},
+		Refresh: func() (interface{}, string, error) {
+			listDeploymentTasksOptions := &clouddatabasesv5.ListDeploymentTasksOptions{
+				ID: core.StringPtr(instanceID),
+			}
+			tasks, response, err := cloudDatabasesClient.ListDeploymentTasks(listDeploymentTasksOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] ListDeploymentTasks on %s failed with error %s %s", instanceID, err, response)
+			}
+			for _, task := range tasks.Tasks {
+				if task.Status == nil {
+					continue
+				}
+				switch *task.Status {
+				case "queued", "running":
+					return tasks, databaseInstanceRestoringStatus, nil
+				case "failed":
+					if task.Description != nil && strings.Contains(strings.ToLower(*task.Description), "restor") {
+						return tasks, "", fmt.Errorf("[ERROR] The restore of database instance %s failed: %s", instanceID, *task.Description)
+					}
+				}
+			}
+			return tasks, databaseInstanceRunningStatus, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func waitForDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -2501,6 +2789,10 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 				return true, nil
 			}
 
+			if getTaskResponse.Task.ProgressPercent != nil && getTaskResponse.Task.Description != nil {
+				log.Printf("[INFO] Database task %s: %s (%d%% complete)", taskId, *getTaskResponse.Task.Description, *getTaskResponse.Task.ProgressPercent)
+			}
+
 			switch *getTaskResponse.Task.Status {
 			case "failed":
 				return false, fmt.Errorf("[Error] Database Task failed")
@@ -2513,6 +2805,34 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
+// waitForDatabaseTasksComplete waits for every queued or running task of the deployment to complete
+func waitForDatabaseTasksComplete(instanceID string, d *schema.ResourceData, meta interface{}, t time.Duration) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	listDeploymentTasksOptions := &clouddatabasesv5.ListDeploymentTasksOptions{
+		ID: core.StringPtr(instanceID),
+	}
+	tasks, response, err := cloudDatabasesClient.ListDeploymentTasks(listDeploymentTasksOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing database (%s) tasks: %s\n%s", instanceID, err, response)
+	}
+
+	for _, task := range tasks.Tasks {
+		if task.ID == nil || task.Status == nil || (*task.Status != "queued" && *task.Status != "running") {
+			continue
+		}
+		_, err = waitForDatabaseTaskComplete(*task.ID, d, meta, t)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -3092,34 +3412,41 @@ func validateUsersDiff(_ context.Context, diff *schema.ResourceDiff, meta interf
 		}
 
 		if change.isCreate() || change.isUpdate() {
-			err = change.New.ValidatePassword()
+			err = validateDatabaseUser(change.New, service, version)
 
 			if err != nil {
 				return err
 			}
+		}
+	}
 
-			// TODO: Use Capability API
-			// RBAC roles supported for Redis 6.0 and above
-			if (service == "databases-for-redis") && !(version > 0 && version < 6) {
-				err = change.New.ValidateRBACRole()
-			} else if service == "databases-for-mongodb" && change.New.Type == "ops_manager" {
-				err = change.New.ValidateOpsManagerRole()
-			} else {
-				if change.New.Role != nil {
-					if *change.New.Role != "" {
-						err = errors.New("role is not supported for this deployment or user type")
-						err = &databaseUserValidationError{user: change.New, errs: []error{err}}
-					}
-				}
-			}
+	return
+}
 
-			if err != nil {
-				return err
+// validateDatabaseUser checks the password and role of a user for the service and major version, 0 meaning the latest version
+func validateDatabaseUser(user *DatabaseUser, service string, version int) (err error) {
+	err = user.ValidatePassword()
+
+	if err != nil {
+		return err
+	}
+
+	// TODO: Use Capability API
+	// RBAC roles supported for Redis 6.0 and above
+	if (service == "databases-for-redis") && !(version > 0 && version < 6) {
+		err = user.ValidateRBACRole()
+	} else if service == "databases-for-mongodb" && user.Type == "ops_manager" {
+		err = user.ValidateOpsManagerRole()
+	} else {
+		if user.Role != nil {
+			if *user.Role != "" {
+				err = errors.New("role is not supported for this deployment or user type")
+				err = &databaseUserValidationError{user: user, errs: []error{err}}
 			}
 		}
 	}
 
-	return
+	return err
 }
 
 func expandUsers(_users []interface{}) []*DatabaseUser {
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseAllowlistEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistEntryCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistEntryRead,
+		DeleteContext: resourceIBMDatabaseAllowlistEntryDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"address": {
+				Description:  "Allowlist IP address in CIDR notation",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCIDR,
+			},
+			"description": {
+				Description:  "Unique allow list description",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseAllowlistEntryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	address := d.Get("address").(string)
+
+	addAllowlistEntryOptions := &clouddatabasesv5.AddAllowlistEntryOptions{
+		ID: &instanceID,
+		IPAddress: &clouddatabasesv5.AllowlistEntry{
+			Address:     core.StringPtr(address),
+			Description: core.StringPtr(d.Get("description").(string)),
+		},
+	}
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntry(addAllowlistEntryOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error adding database (%s) allowlist entry %s: %s\n%s", instanceID, address, err, response))
+	}
+
+	taskId := *addAllowlistEntryResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskId, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry %s add task to complete: %s", instanceID, address, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, address))
+
+	return resourceIBMDatabaseAllowlistEntryRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistEntryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, address, err := splitDatabaseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getAllowlistOptions := &clouddatabasesv5.GetAllowlistOptions{
+		ID: &instanceID,
+	}
+
+	allowlist, response, err := cloudDatabasesClient.GetAllowlist(getAllowlistOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) not found, removing allowlist entry %s from state", instanceID, address)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) allowlist: %s\n%s", instanceID, err, response))
+	}
+
+	for _, entry := range allowlist.IPAddresses {
+		if entry.Address != nil && *entry.Address == address {
+			d.Set("instance_id", instanceID)
+			d.Set("address", entry.Address)
+			d.Set("description", entry.Description)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Database (%s) allowlist entry %s not found, removing from state", instanceID, address)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	address := d.Get("address").(string)
+
+	deleteAllowlistEntryOptions := &clouddatabasesv5.DeleteAllowlistEntryOptions{
+		ID:        &instanceID,
+		Ipaddress: core.StringPtr(address),
+	}
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntry(deleteAllowlistEntryOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting database (%s) allowlist entry %s: %s\n%s", instanceID, address, err, response))
+	}
+
+	taskId := *deleteAllowlistEntryResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskId, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry %s delete task to complete: %s", instanceID, address, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAutoscalingCreate,
+		ReadContext:   resourceIBMDatabaseAutoscalingRead,
+		UpdateContext: resourceIBMDatabaseAutoscalingUpdate,
+		DeleteContext: resourceIBMDatabaseAutoscalingDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Description: "The scaling group the auto scaling conditions apply to",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "member",
+			},
+			"disk": {
+				Type:        schema.TypeList,
+				Description: "Disk Auto Scaling",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        resourceIBMDatabaseAutoscalingDiskSchema(),
+			},
+			"memory": {
+				Type:        schema.TypeList,
+				Description: "Memory Auto Scaling",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem:        resourceIBMDatabaseAutoscalingMemorySchema(),
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseAutoscalingCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	autoscaling, err := expandDatabaseAutoscaling(d, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setDatabaseAutoscaling(instanceID, groupID, autoscaling, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, groupID))
+
+	return resourceIBMDatabaseAutoscalingRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAutoscalingRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, groupID, err := splitDatabaseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getAutoscalingConditionsOptions := &clouddatabasesv5.GetAutoscalingConditionsOptions{
+		ID:      &instanceID,
+		GroupID: &groupID,
+	}
+
+	autoscalingGroup, response, err := cloudDatabasesClient.GetAutoscalingConditions(getAutoscalingConditionsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) not found, removing auto scaling group %s from state", instanceID, groupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) autoscaling group %s: %s\n%s", instanceID, groupID, err, response))
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("group_id", groupID)
+
+	autoscaling := flattenAutoScalingGroup(*autoscalingGroup)
+	if len(autoscaling) > 0 {
+		d.Set("disk", autoscaling[0]["disk"])
+		d.Set("memory", autoscaling[0]["memory"])
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseAutoscalingUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	if d.HasChanges("disk", "memory") {
+		autoscaling, err := expandDatabaseAutoscaling(d, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = setDatabaseAutoscaling(instanceID, groupID, autoscaling, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseAutoscalingRead(context, d, meta)
+}
+
+// Deleting disables the disk and memory scalers, the rate settings are left as they are
+func resourceIBMDatabaseAutoscalingDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	autoscaling := &clouddatabasesv5.AutoscalingSetGroupAutoscaling{
+		Disk: &clouddatabasesv5.AutoscalingDiskGroupDisk{
+			Scalers: &clouddatabasesv5.AutoscalingDiskGroupDiskScalers{
+				Capacity: &clouddatabasesv5.AutoscalingDiskGroupDiskScalersCapacity{
+					Enabled: core.BoolPtr(false),
+				},
+				IoUtilization: &clouddatabasesv5.AutoscalingDiskGroupDiskScalersIoUtilization{
+					Enabled: core.BoolPtr(false),
+				},
+			},
+		},
+		Memory: &clouddatabasesv5.AutoscalingMemoryGroupMemory{
+			Scalers: &clouddatabasesv5.AutoscalingMemoryGroupMemoryScalers{
+				IoUtilization: &clouddatabasesv5.AutoscalingMemoryGroupMemoryScalersIoUtilization{
+					Enabled: core.BoolPtr(false),
+				},
+			},
+		},
+	}
+
+	err := setDatabaseAutoscaling(instanceID, groupID, autoscaling, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// expandDatabaseAutoscaling builds the disk and memory groups, on update only the changed groups are sent
+func expandDatabaseAutoscaling(d *schema.ResourceData, all bool) (*clouddatabasesv5.AutoscalingSetGroupAutoscaling, error) {
+	autoscaling := &clouddatabasesv5.AutoscalingSetGroupAutoscaling{}
+
+	if diskRecord, ok := d.GetOk("disk"); ok && (all || d.HasChange("disk")) {
+		diskBody, err := expandAutoscalingDiskGroup(d, diskRecord)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error in getting diskBody from expandAutoscalingDiskGroup %s", err)
+		}
+		autoscaling.Disk = diskBody
+	}
+
+	if memoryRecord, ok := d.GetOk("memory"); ok && (all || d.HasChange("memory")) {
+		memoryBody, err := expandAutoscalingMemoryGroup(d, memoryRecord)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error in getting memoryBody from expandAutoscalingMemoryGroup %s", err)
+		}
+		autoscaling.Memory = memoryBody
+	}
+
+	return autoscaling, nil
+}
+
+func setDatabaseAutoscaling(instanceID string, groupID string, autoscaling *clouddatabasesv5.AutoscalingSetGroupAutoscaling, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	if autoscaling.Disk == nil && autoscaling.Memory == nil {
+		return nil
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	setAutoscalingConditionsOptions := &clouddatabasesv5.SetAutoscalingConditionsOptions{
+		ID:          &instanceID,
+		GroupID:     &groupID,
+		Autoscaling: autoscaling,
+	}
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	setAutoscalingConditionsResponse, response, err := cloudDatabasesClient.SetAutoscalingConditions(setAutoscalingConditionsOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating database (%s) auto_scaling group %s: %s\n%s", instanceID, groupID, err, response)
+	}
+
+	taskId := *setAutoscalingConditionsResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskId, d, meta, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) auto scaling group %s update task to complete: %s", instanceID, groupID, err)
+	}
+
+	return nil
+}
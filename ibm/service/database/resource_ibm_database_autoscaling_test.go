@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseAutoscalingBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-as-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database_autoscaling.autoscaling"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseAutoscalingBasic(databaseResourceGroup, serviceName, 15),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", "member"),
+					resource.TestCheckResourceAttr(resourceName, "disk.0.capacity_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "disk.0.free_space_less_than_percent", "15"),
+					resource.TestCheckResourceAttr(resourceName, "memory.0.io_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseAutoscalingBasic(databaseResourceGroup, serviceName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disk.0.free_space_less_than_percent", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseAutoscalingBasic(databaseResourceGroup string, name string, freeSpace int) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+	}
+
+	resource "ibm_database_autoscaling" "autoscaling" {
+		instance_id = ibm_database.%[2]s.id
+		disk {
+			capacity_enabled             = true
+			free_space_less_than_percent = %[4]d
+			io_above_percent             = 90
+			io_enabled                   = true
+			io_over_period               = "15m"
+		}
+		memory {
+			io_above_percent = 90
+			io_enabled       = true
+			io_over_period   = "15m"
+		}
+	}
+				`, databaseResourceGroup, name, acc.Region(), freeSpace)
+}
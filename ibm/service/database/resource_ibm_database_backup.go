@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The CRN of the database deployment to back up",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, starts a new on-demand backup",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"backup_id": {
+				Description: "The CRN of the backup",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The type of backup",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of the backup",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_downloadable": {
+				Description: "Is this backup available to download?",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"is_restorable": {
+				Description: "Can this backup be used to restore an instance?",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Date and time when the backup was created",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseBackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	backup, err := startDatabaseBackup(deploymentID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*backup.ID)
+
+	return resourceIBMDatabaseBackupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getBackupInfoOptions := &clouddatabasesv5.GetBackupInfoOptions{
+		BackupID: core.StringPtr(d.Id()),
+	}
+
+	backup, response, err := cloudDatabasesClient.GetBackupInfo(getBackupInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database backup (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database backup (%s): %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("backup_id", backup.Backup.ID)
+	d.Set("deployment_id", backup.Backup.DeploymentID)
+	d.Set("type", backup.Backup.Type)
+	d.Set("status", backup.Backup.Status)
+	d.Set("is_downloadable", backup.Backup.IsDownloadable)
+	d.Set("is_restorable", backup.Backup.IsRestorable)
+	d.Set("created_at", flex.DateTimeToString(backup.Backup.CreatedAt))
+
+	return nil
+}
+
+// Backups cannot be deleted through the API, they expire with the retention period of the deployment
+func resourceIBMDatabaseBackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+// startDatabaseBackup takes an on-demand backup of the deployment and returns it once the backup task is complete
+func startDatabaseBackup(deploymentID string, d *schema.ResourceData, meta interface{}, timeout time.Duration) (*clouddatabasesv5.Backup, error) {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	// Backups only report their creation time, so the new backup is the latest on-demand backup created after the start
+	startedAt := time.Now().Add(-1 * time.Minute)
+
+	startOndemandBackupOptions := &clouddatabasesv5.StartOndemandBackupOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackup(startOndemandBackupOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error starting on-demand backup of database (%s): %s\n%s", deploymentID, err, response)
+	}
+
+	taskID := *startOndemandBackupResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, timeout)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) on-demand backup task to complete: %s", deploymentID, err)
+	}
+
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackups(listDeploymentBackupsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing backups of database (%s): %s\n%s", deploymentID, err, response)
+	}
+
+	var backup *clouddatabasesv5.Backup
+	for i, b := range backups.Backups {
+		if b.Type == nil || *b.Type != clouddatabasesv5.BackupTypeOnDemandConst || b.CreatedAt == nil {
+			continue
+		}
+		if time.Time(*b.CreatedAt).Before(startedAt) {
+			continue
+		}
+		if backup == nil || time.Time(*b.CreatedAt).After(time.Time(*backup.CreatedAt)) {
+			backup = &backups.Backups[i]
+		}
+	}
+
+	if backup == nil {
+		return nil, fmt.Errorf("[ERROR] The on-demand backup task of database (%s) completed but no new backup was found", deploymentID)
+	}
+
+	return backup, nil
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseBackupBasic(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-bkp-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database_backup.backup"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseBackupBasic(databaseResourceGroup, serviceName, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "backup_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "on_demand"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "is_restorable", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseBackupBasic(databaseResourceGroup, serviceName, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "two"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseBackupCrossRegionRestore(t *testing.T) {
+	if acc.IcdDbBackupEncryptionKey == "" {
+		t.Skip("ICD_DB_BACKUP_ENCRYPTION_KEY_CRN must be set for cross-region ibm_database restore tests")
+	}
+	t.Parallel()
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-dr-%d", acctest.RandIntRange(10, 100))
+	restoreName := serviceName + "-restore"
+	restoreResource := "ibm_database." + restoreName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseBackupCrossRegionRestore(databaseResourceGroup, serviceName, restoreName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(restoreResource, "name", restoreName),
+					resource.TestCheckResourceAttr(restoreResource, "location", acc.IcdDbRestoreRegion),
+					resource.TestCheckResourceAttr(restoreResource, "status", "active"),
+					resource.TestCheckResourceAttrPair(restoreResource, "backup_id", "ibm_database_backup.backup", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupBasic(databaseResourceGroup string, name string, release string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+	}
+
+	resource "ibm_database_backup" "backup" {
+		deployment_id = ibm_database.%[2]s.id
+		triggers = {
+			release = "%[4]s"
+		}
+	}
+				`, databaseResourceGroup, name, acc.Region(), release)
+}
+
+func testAccCheckIBMDatabaseBackupCrossRegionRestore(databaseResourceGroup string, name string, restoreName string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		alias  = "dr"
+		region = "%[5]s"
+	}
+
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[4]s"
+	}
+
+	resource "ibm_database_backup" "backup" {
+		deployment_id = ibm_database.%[2]s.id
+	}
+
+	resource "ibm_database" "%[3]s" {
+		provider                  = ibm.dr
+		resource_group_id         = data.ibm_resource_group.test_acc.id
+		name                      = "%[3]s"
+		service                   = "databases-for-postgresql"
+		plan                      = "standard"
+		location                  = "%[5]s"
+		backup_id                 = ibm_database_backup.backup.id
+		backup_encryption_key_crn = "%[6]s"
+	}
+				`, databaseResourceGroup, name, restoreName, acc.Region(), acc.IcdDbRestoreRegion, acc.IcdDbBackupEncryptionKey)
+}
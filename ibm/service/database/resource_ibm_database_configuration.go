@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseConfigurationCreate,
+		ReadContext:   resourceIBMDatabaseConfigurationRead,
+		UpdateContext: resourceIBMDatabaseConfigurationUpdate,
+		DeleteContext: resourceIBMDatabaseConfigurationDelete,
+		CustomizeDiff: resourceIBMDatabaseConfigurationDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"configuration": {
+				Description: "The database configuration in JSON format",
+				Type:        schema.TypeString,
+				Required:    true,
+				StateFunc: func(v interface{}) string {
+					json, err := flex.NormalizeJSONString(v)
+					if err != nil {
+						return fmt.Sprintf("%q", err.Error())
+					}
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	err := updateDatabaseConfiguration(instanceID, d.Get("configuration").(string), d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	// The Cloud Databases API does not return the applied configuration, so only the deployment is checked for existence
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	}
+	_, response, err := cloudDatabasesClient.GetDeploymentInfo(getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) not found, removing configuration from state", instanceID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response))
+	}
+
+	d.Set("instance_id", instanceID)
+
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+
+	if d.HasChange("configuration") {
+		conns.IbmMutexKV.Lock(instanceID)
+		defer conns.IbmMutexKV.Unlock(instanceID)
+
+		err := updateDatabaseConfiguration(instanceID, d.Get("configuration").(string), d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+// The applied configuration stays on the deployment, deleting only removes it from the state
+func resourceIBMDatabaseConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	instanceID := diff.Get("instance_id").(string)
+	configJSON := diff.Get("configuration").(string)
+	if !diff.NewValueKnown("instance_id") || !diff.NewValueKnown("configuration") || instanceID == "" {
+		return nil
+	}
+
+	crn, err := flex.Parse(instanceID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Invalid database instance_id %s: %s", instanceID, err)
+	}
+
+	return validateDatabaseConfiguration(crn.ServiceName, configJSON)
+}
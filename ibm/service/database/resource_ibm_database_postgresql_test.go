@@ -756,3 +756,55 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup s
 	}
 				`, databaseResourceGroup, name, acc.Region())
 }
+
+func TestAccIBMDatabaseInstancePostgresVersionUpgrade(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	serviceName := fmt.Sprintf("tf-Pgress-upg-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, serviceName, "14"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "version", "14"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, serviceName, "12"),
+				ExpectError: regexp.MustCompile("cannot be downgraded"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup, serviceName, "15"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "version", "15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseInstancePostgresVersion(databaseResourceGroup string, name string, version string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		version           = "%[4]s"
+	}
+				`, databaseResourceGroup, name, acc.Region(), version)
+}
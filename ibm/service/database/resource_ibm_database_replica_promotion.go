@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMDatabaseReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseReplicaPromotionCreate,
+		ReadContext:   resourceIBMDatabaseReplicaPromotionRead,
+		DeleteContext: resourceIBMDatabaseReplicaPromotionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The CRN of the read-only replica to promote",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"skip_initial_backup": {
+				Description: "Skip the initial backup of the promoted deployment",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"leader": {
+				Description: "The CRN of the leader of the deployment, empty once the replica is promoted",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"replicas": {
+				Description: "The CRNs of the read-only replicas of the deployment",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseReplicaPromotionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{
+		ID: core.StringPtr(deploymentID),
+	}
+
+	remotes, response, err := cloudDatabasesClient.ListRemotes(listRemotesOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) remotes: %s\n%s", deploymentID, err, response))
+	}
+
+	if remotes.Remotes == nil || remotes.Remotes.Leader == nil || *remotes.Remotes.Leader == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] Database (%s) is not a read-only replica and cannot be promoted", deploymentID))
+	}
+
+	promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+		ID: core.StringPtr(deploymentID),
+		Promotion: map[string]interface{}{
+			"skip_initial_backup": d.Get("skip_initial_backup").(bool),
+		},
+	}
+
+	conns.IbmMutexKV.Lock(deploymentID)
+	defer conns.IbmMutexKV.Unlock(deploymentID)
+
+	promoteReadOnlyReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplica(promoteReadOnlyReplicaOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error promoting database (%s) replica of %s: %s\n%s", deploymentID, *remotes.Remotes.Leader, err, response))
+	}
+
+	taskID := *promoteReadOnlyReplicaResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) replica promotion task to complete: %s", deploymentID, err))
+	}
+
+	d.SetId(deploymentID)
+
+	return resourceIBMDatabaseReplicaPromotionRead(context, d, meta)
+}
+
+func resourceIBMDatabaseReplicaPromotionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{
+		ID: core.StringPtr(d.Id()),
+	}
+
+	remotes, response, err := cloudDatabasesClient.ListRemotes(listRemotesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) not found, removing replica promotion from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) remotes: %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("deployment_id", d.Id())
+
+	leader := ""
+	replicas := []string{}
+	if remotes.Remotes != nil {
+		if remotes.Remotes.Leader != nil {
+			leader = *remotes.Remotes.Leader
+		}
+		if remotes.Remotes.Replicas != nil {
+			replicas = remotes.Remotes.Replicas
+		}
+	}
+	d.Set("leader", leader)
+	d.Set("replicas", replicas)
+
+	return nil
+}
+
+// A promotion cannot be undone, deleting only removes it from the state
+func resourceIBMDatabaseReplicaPromotionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
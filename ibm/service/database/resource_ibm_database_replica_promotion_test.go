@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseReplicaPromotionBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+	resourceName := "ibm_database_replica_promotion.promotion"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseDataSourceConfig4(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_database.db_replica", "remote_leader_id", "ibm_database.db", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseReplicaPromotionBasic(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_id", "ibm_database.db_replica", "id"),
+					resource.TestCheckResourceAttr(resourceName, "skip_initial_backup", "true"),
+					resource.TestCheckResourceAttr(resourceName, "leader", ""),
+					resource.TestCheckResourceAttr("data.ibm_database_remotes.promoted", "leader", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_initial_backup"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReplicaPromotionBasic(name string) string {
+	return testAccCheckIBMDatabaseDataSourceConfig4(name) + `
+	resource "ibm_database_replica_promotion" "promotion" {
+		deployment_id       = ibm_database.db_replica.id
+		skip_initial_backup = true
+	}
+
+	data "ibm_database_remotes" "promoted" {
+		deployment_id = ibm_database_replica_promotion.promotion.deployment_id
+	}
+	`
+}
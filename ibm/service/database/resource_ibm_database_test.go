@@ -4,6 +4,7 @@
 package database
 
 import (
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"gotest.tools/assert"
 	"testing"
@@ -193,3 +194,121 @@ func TestValidateRBACRole(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitDatabaseID(t *testing.T) {
+	crn := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/40ddc34a953a8c02f10987b59085b60e:5042afe1-72c2-4231-89cc-c949e5d56251::"
+
+	testcases := []struct {
+		id             string
+		expectedID     string
+		expectedSuffix string
+		expectedError  bool
+	}{
+		{id: crn + "/database/user123", expectedID: crn, expectedSuffix: "database/user123"},
+		{id: crn + "/172.168.1.2/32", expectedID: crn, expectedSuffix: "172.168.1.2/32"},
+		{id: crn + "/member", expectedID: crn, expectedSuffix: "member"},
+		{id: crn, expectedError: true},
+		{id: "user123", expectedError: true},
+	}
+	for _, tc := range testcases {
+		instanceID, suffix, err := splitDatabaseID(tc.id)
+		if tc.expectedError {
+			if err == nil {
+				t.Errorf("TestSplitDatabaseID: %q expected an error", tc.id)
+			}
+			continue
+		}
+
+		assert.NilError(t, err)
+		assert.Equal(t, tc.expectedID, instanceID)
+		assert.Equal(t, tc.expectedSuffix, suffix)
+	}
+}
+
+func TestValidateDatabaseRestore(t *testing.T) {
+	backupID := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/40ddc34a953a8c02f10987b59085b60e:5042afe1-72c2-4231-89cc-c949e5d56251:backup:0d862fdb-4faa-42e5-aecb-5057f4d399c3"
+	usEastKey := "crn:v1:bluemix:public:kms:us-east:a/40ddc34a953a8c02f10987b59085b60e:4b9b0f2b-c8f1-4c7c-9a7e-3e2b3c3c7b0d:key:0a7f6b1e-5e3c-4b6e-9d8a-2f3e4c5d6a7b"
+	usSouthKey := "crn:v1:bluemix:public:hs-crypto:us-south:a/40ddc34a953a8c02f10987b59085b60e:4b9b0f2b-c8f1-4c7c-9a7e-3e2b3c3c7b0d:key:0a7f6b1e-5e3c-4b6e-9d8a-2f3e4c5d6a7b"
+
+	testcases := []struct {
+		service       string
+		location      string
+		backupID      string
+		keyCRN        string
+		expectedError bool
+	}{
+		{service: "databases-for-postgresql", location: "us-south", backupID: backupID},
+		{service: "databases-for-postgresql", location: "us-east", backupID: backupID},
+		{service: "databases-for-postgresql", location: "us-east", backupID: backupID, keyCRN: usEastKey},
+		{service: "databases-for-postgresql", location: "us-south", backupID: backupID, keyCRN: usEastKey},
+		{service: "databases-for-postgresql", location: "us-east", backupID: backupID, keyCRN: usSouthKey, expectedError: true},
+		{service: "databases-for-postgresql", location: "us-east", backupID: backupID, keyCRN: backupID, expectedError: true},
+		{service: "databases-for-mysql", location: "us-south", backupID: backupID, expectedError: true},
+		{service: "databases-for-postgresql", location: "us-south", backupID: "0d862fdb-4faa-42e5-aecb-5057f4d399c3", expectedError: true},
+	}
+	for _, tc := range testcases {
+		err := validateDatabaseRestore(tc.service, tc.location, tc.backupID, tc.keyCRN)
+		if tc.expectedError && err == nil {
+			t.Errorf("TestValidateDatabaseRestore: %s to %s with key %q expected an error", tc.service, tc.location, tc.keyCRN)
+		}
+		if !tc.expectedError && err != nil {
+			t.Errorf("TestValidateDatabaseRestore: %s to %s with key %q unexpected error: %q", tc.service, tc.location, tc.keyCRN, err.Error())
+		}
+	}
+}
+
+func TestCheckDatabaseVersionUpgrade(t *testing.T) {
+	transition := func(from, to string) clouddatabasesv5.DeployablesVersionsItemTransitionsItem {
+		return clouddatabasesv5.DeployablesVersionsItemTransitionsItem{
+			Application: core.StringPtr("postgresql"),
+			Method:      core.StringPtr("in-place"),
+			FromVersion: core.StringPtr(from),
+			ToVersion:   core.StringPtr(to),
+		}
+	}
+	deployables := []clouddatabasesv5.Deployables{
+		{
+			Type: core.StringPtr("postgresql"),
+			Versions: []clouddatabasesv5.DeployablesVersionsItem{
+				{Version: core.StringPtr("15"), Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{transition("14", "15")}},
+				{Version: core.StringPtr("16"), Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{transition("14", "16"), transition("15", "16")}},
+			},
+		},
+		{
+			Type: core.StringPtr("mysql"),
+			Versions: []clouddatabasesv5.DeployablesVersionsItem{
+				{Version: core.StringPtr("8.0"), Transitions: []clouddatabasesv5.DeployablesVersionsItemTransitionsItem{transition("5.7", "8.0")}},
+			},
+		},
+	}
+
+	assert.NilError(t, checkDatabaseVersionUpgrade(deployables, "postgresql", "14", "16"))
+	assert.NilError(t, checkDatabaseVersionUpgrade(deployables, "postgresql", "15", "16"))
+	assert.Error(t, checkDatabaseVersionUpgrade(deployables, "postgresql", "13", "16"), "[ERROR] postgresql version 13 has no in-place upgrade path to 16")
+	assert.Error(t, checkDatabaseVersionUpgrade(deployables, "mysql", "5.7", "8.4"), "[ERROR] mysql cannot be upgraded in place from version 5.7 to 8.4, upgrade to one of 8.0 first")
+	assert.Error(t, checkDatabaseVersionUpgrade(deployables, "redis", "6.2", "7.2"), "[ERROR] redis version 6.2 has no in-place upgrade path to 7.2")
+}
+
+func TestCompareDatabaseVersions(t *testing.T) {
+	testcases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "14", b: "16", expected: -1},
+		{a: "16", b: "14", expected: 1},
+		{a: "7.2", b: "7.10", expected: -1},
+		{a: "7.10", b: "7.2", expected: 1},
+		{a: "8.0", b: "8", expected: 0},
+		{a: "5.7", b: "8.0", expected: -1},
+		{a: "4.4", b: "4.4", expected: 0},
+	}
+	for _, tc := range testcases {
+		cmp, err := compareDatabaseVersions(tc.a, tc.b)
+		assert.NilError(t, err)
+		assert.Equal(t, tc.expected, cmp, "compareDatabaseVersions(%q, %q)", tc.a, tc.b)
+	}
+
+	_, err := compareDatabaseVersions("7.x", "7.2")
+	assert.Error(t, err, "invalid version: 7.x")
+}
@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		CustomizeDiff: resourceIBMDatabaseUserDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:  "User type",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validate.InvokeValidator("ibm_database_user", "type"),
+			},
+			"name": {
+				Description:  "User name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 32),
+			},
+			"password": {
+				Description:  "User password",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(15, 32),
+			},
+			"role": {
+				Description: "User role. Only available for ops_manager user type and Redis 6.0 and above.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func ResourceIBMDatabaseUserValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "database, ops_manager, read_only_replica",
+		})
+
+	ibmDatabaseUserResourceValidator := validate.ResourceValidator{ResourceName: "ibm_database_user", Schema: validateSchema}
+	return &ibmDatabaseUserResourceValidator
+}
+
+func expandDatabaseUser(d *schema.ResourceData) *DatabaseUser {
+	user := &DatabaseUser{
+		Username: d.Get("name").(string),
+		Password: d.Get("password").(string),
+		Type:     d.Get("type").(string),
+	}
+
+	if role, ok := d.GetOk("role"); ok {
+		user.Role = core.StringPtr(role.(string))
+	}
+
+	return user
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	user := expandDatabaseUser(d)
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	// Note: Some db users exist after provisioning (i.e. admin, repl)
+	// so we must attempt both methods
+	if err := user.Update(instanceID, d, meta); err != nil {
+		if err = user.Create(instanceID, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, user.Type, user.Username))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, userID, err := splitDatabaseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts := strings.SplitN(userID, "/", 2)
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of the database CRN, user type and user name separated by /", d.Id()))
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	// The Cloud Databases API does not list users, so only the deployment is checked for existence
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	}
+	_, response, err := cloudDatabasesClient.GetDeploymentInfo(getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) not found, removing user %s from state", instanceID, parts[1])
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response))
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("type", parts[0])
+	d.Set("name", parts[1])
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	user := expandDatabaseUser(d)
+
+	if d.HasChanges("password", "role") {
+		conns.IbmMutexKV.Lock(instanceID)
+		defer conns.IbmMutexKV.Unlock(instanceID)
+
+		var err error
+		// Note: User Update is not supported for ops_manager user type
+		// Delete (ignoring errors), then re-create
+		if !user.isUpdatable() {
+			user.Delete(instanceID, d, meta)
+
+			err = user.Create(instanceID, d, meta)
+		} else {
+			err = user.Update(instanceID, d, meta)
+		}
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	user := expandDatabaseUser(d)
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	if err := user.Delete(instanceID, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMDatabaseUserDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if !diff.NewValueKnown("password") || !diff.NewValueKnown("role") {
+		return nil
+	}
+
+	user := &DatabaseUser{
+		Username: diff.Get("name").(string),
+		Password: diff.Get("password").(string),
+		Type:     diff.Get("type").(string),
+	}
+	if role, ok := diff.GetOk("role"); ok {
+		user.Role = core.StringPtr(role.(string))
+	}
+
+	// The service is only known once the deployment exists, until then only the password is validated
+	instanceID := diff.Get("instance_id").(string)
+	if !diff.NewValueKnown("instance_id") || instanceID == "" {
+		return user.ValidatePassword()
+	}
+
+	crn, err := flex.Parse(instanceID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Invalid database instance_id %s: %s", instanceID, err)
+	}
+
+	var version int
+	if crn.ServiceName == "databases-for-redis" && user.Role != nil {
+		cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+		}
+
+		getDeploymentInfoResponse, response, err := cloudDatabasesClient.GetDeploymentInfo(&clouddatabasesv5.GetDeploymentInfoOptions{
+			ID: core.StringPtr(instanceID),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response)
+		}
+
+		if getDeploymentInfoResponse.Deployment.Version != nil {
+			_v, err := strconv.ParseFloat(*getDeploymentInfoResponse.Deployment.Version, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version: %s", *getDeploymentInfoResponse.Deployment.Version)
+			}
+			version = int(_v)
+		}
+	}
+
+	return validateDatabaseUser(user, crn.ServiceName, version)
+}
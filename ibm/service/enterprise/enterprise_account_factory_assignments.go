@@ -0,0 +1,412 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+const (
+	accountFactoryTemplateAccountSettings = "account_settings"
+	accountFactoryTemplateTrustedProfile  = "trusted_profile"
+	accountFactoryTemplateAccessGroup     = "access_group"
+	accountFactoryTemplatePolicy          = "policy"
+
+	accountFactoryAssignmentPending   = "in_progress"
+	accountFactoryAssignmentSucceeded = "succeeded"
+	accountFactoryAssignmentFailed    = "failed"
+
+	accountFactoryTargetType = "Account"
+)
+
+// accountFactoryTemplateTypes are the template types in the order they are assigned. Account settings come first so that
+// the baseline of the account is in place before identities and access are added
+var accountFactoryTemplateTypes = []string{
+	accountFactoryTemplateAccountSettings,
+	accountFactoryTemplateTrustedProfile,
+	accountFactoryTemplateAccessGroup,
+	accountFactoryTemplatePolicy,
+}
+
+// accountFactoryTemplate is a template version to assign to the account
+type accountFactoryTemplate struct {
+	Type    string
+	ID      string
+	Version string
+}
+
+func (t accountFactoryTemplate) key() string {
+	return t.Type + "/" + t.ID
+}
+
+// accountFactoryAssignment is the assignment of a template version to the account
+type accountFactoryAssignment struct {
+	accountFactoryTemplate
+	AssignmentID string
+	Status       string
+}
+
+// accountFactoryAssigner assigns the templates of a type through the API of the service owning them
+type accountFactoryAssigner interface {
+	create(ctx context.Context, template accountFactoryTemplate, accountID string) (string, error)
+	update(ctx context.Context, assignmentID string, version string) error
+	delete(ctx context.Context, assignmentID string) error
+	// status returns the normalized status of an assignment, or found false when it no longer exists
+	status(ctx context.Context, assignmentID string) (status string, found bool, err error)
+}
+
+func newAccountFactoryAssigner(meta interface{}, templateType string) (accountFactoryAssigner, error) {
+	switch templateType {
+	case accountFactoryTemplateAccountSettings, accountFactoryTemplateTrustedProfile:
+		client, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return nil, err
+		}
+		if templateType == accountFactoryTemplateAccountSettings {
+			return &accountSettingsAssigner{client: client}, nil
+		}
+		return &trustedProfileAssigner{client: client}, nil
+	case accountFactoryTemplateAccessGroup:
+		client, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+		if err != nil {
+			return nil, err
+		}
+		return &accessGroupAssigner{client: client}, nil
+	case accountFactoryTemplatePolicy:
+		client, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return nil, err
+		}
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return nil, err
+		}
+		return &policyAssigner{client: client, requesterID: userDetails.UserID}, nil
+	}
+	return nil, fmt.Errorf("[ERROR] Unsupported template type %s", templateType)
+}
+
+// normalizeAccountFactoryAssignmentStatus maps the statuses of the template services to pending, succeeded or failed
+func normalizeAccountFactoryAssignmentStatus(status *string) string {
+	if status == nil {
+		return accountFactoryAssignmentPending
+	}
+	switch *status {
+	case "succeeded":
+		return accountFactoryAssignmentSucceeded
+	case "failed", "succeed_with_errors":
+		return accountFactoryAssignmentFailed
+	}
+	return accountFactoryAssignmentPending
+}
+
+// sortAccountFactoryTemplates sorts templates in assignment order
+func sortAccountFactoryTemplates(templates []accountFactoryTemplate) {
+	order := map[string]int{}
+	for i, t := range accountFactoryTemplateTypes {
+		order[t] = i
+	}
+	sort.SliceStable(templates, func(i, j int) bool {
+		return order[templates[i].Type] < order[templates[j].Type]
+	})
+}
+
+// planAccountFactoryAssignments compares the templates to assign with the existing assignments. It returns the
+// templates to assign, the assignments to move to another version or to retry, and the assignments to remove
+func planAccountFactoryAssignments(templates []accountFactoryTemplate, assignments []accountFactoryAssignment) (create []accountFactoryTemplate, update []accountFactoryAssignment, remove []accountFactoryAssignment) {
+	existing := map[string]accountFactoryAssignment{}
+	for _, a := range assignments {
+		existing[a.key()] = a
+	}
+	wanted := map[string]bool{}
+	for _, t := range templates {
+		wanted[t.key()] = true
+		a, ok := existing[t.key()]
+		switch {
+		case !ok || a.AssignmentID == "":
+			// Templates whose assignment could not be created are assigned again
+			create = append(create, t)
+		case a.Version != t.Version || a.Status == accountFactoryAssignmentFailed:
+			a.Version = t.Version
+			update = append(update, a)
+		}
+	}
+	for _, a := range assignments {
+		if !wanted[a.key()] && a.AssignmentID != "" {
+			remove = append(remove, a)
+		}
+	}
+	return create, update, remove
+}
+
+// accountFactoryAssignmentsOutOfDate returns whether the assignments have to be applied again, because a template has
+// no assignment or is assigned in another version, or an assignment did not succeed
+func accountFactoryAssignmentsOutOfDate(templates []accountFactoryTemplate, assignments []accountFactoryAssignment) bool {
+	for _, a := range assignments {
+		if a.Status != accountFactoryAssignmentSucceeded {
+			return true
+		}
+	}
+	create, update, _ := planAccountFactoryAssignments(templates, assignments)
+	return len(create) > 0 || len(update) > 0
+}
+
+type accountSettingsAssigner struct {
+	client *iamidentityv1.IamIdentityV1
+}
+
+func (a *accountSettingsAssigner) create(ctx context.Context, template accountFactoryTemplate, accountID string) (string, error) {
+	version, err := strconv.ParseInt(template.Version, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] The version %s of account settings template %s must be a number", template.Version, template.ID)
+	}
+	options := &iamidentityv1.CreateAccountSettingsAssignmentOptions{}
+	options.SetTemplateID(template.ID)
+	options.SetTemplateVersion(version)
+	options.SetTargetType(accountFactoryTargetType)
+	options.SetTarget(accountID)
+	assignment, response, err := a.client.CreateAccountSettingsAssignmentWithContext(ctx, options)
+	if err != nil {
+		return "", fmt.Errorf("CreateAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return *assignment.ID, nil
+}
+
+func (a *accountSettingsAssigner) update(ctx context.Context, assignmentID string, version string) error {
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return fmt.Errorf("[ERROR] The account settings template version %s must be a number", version)
+	}
+	assignment, response, err := a.client.GetAccountSettingsAssignmentWithContext(ctx, &iamidentityv1.GetAccountSettingsAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil {
+		return fmt.Errorf("GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	options := &iamidentityv1.UpdateAccountSettingsAssignmentOptions{}
+	options.SetAssignmentID(assignmentID)
+	options.SetIfMatch(*assignment.EntityTag)
+	options.SetTemplateVersion(v)
+	_, response, err = a.client.UpdateAccountSettingsAssignmentWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *accountSettingsAssigner) delete(ctx context.Context, assignmentID string) error {
+	_, response, err := a.client.DeleteAccountSettingsAssignmentWithContext(ctx, &iamidentityv1.DeleteAccountSettingsAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("DeleteAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *accountSettingsAssigner) status(ctx context.Context, assignmentID string) (string, bool, error) {
+	assignment, response, err := a.client.GetAccountSettingsAssignmentWithContext(ctx, &iamidentityv1.GetAccountSettingsAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return normalizeAccountFactoryAssignmentStatus(assignment.Status), true, nil
+}
+
+type trustedProfileAssigner struct {
+	client *iamidentityv1.IamIdentityV1
+}
+
+func (a *trustedProfileAssigner) create(ctx context.Context, template accountFactoryTemplate, accountID string) (string, error) {
+	version, err := strconv.ParseInt(template.Version, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] The version %s of trusted profile template %s must be a number", template.Version, template.ID)
+	}
+	options := &iamidentityv1.CreateTrustedProfileAssignmentOptions{}
+	options.SetTemplateID(template.ID)
+	options.SetTemplateVersion(version)
+	options.SetTargetType(accountFactoryTargetType)
+	options.SetTarget(accountID)
+	assignment, response, err := a.client.CreateTrustedProfileAssignmentWithContext(ctx, options)
+	if err != nil {
+		return "", fmt.Errorf("CreateTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return *assignment.ID, nil
+}
+
+func (a *trustedProfileAssigner) update(ctx context.Context, assignmentID string, version string) error {
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return fmt.Errorf("[ERROR] The trusted profile template version %s must be a number", version)
+	}
+	assignment, response, err := a.client.GetTrustedProfileAssignmentWithContext(ctx, &iamidentityv1.GetTrustedProfileAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil {
+		return fmt.Errorf("GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	options := &iamidentityv1.UpdateTrustedProfileAssignmentOptions{}
+	options.SetAssignmentID(assignmentID)
+	options.SetIfMatch(*assignment.EntityTag)
+	options.SetTemplateVersion(v)
+	_, response, err = a.client.UpdateTrustedProfileAssignmentWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *trustedProfileAssigner) delete(ctx context.Context, assignmentID string) error {
+	_, response, err := a.client.DeleteTrustedProfileAssignmentWithContext(ctx, &iamidentityv1.DeleteTrustedProfileAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("DeleteTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *trustedProfileAssigner) status(ctx context.Context, assignmentID string) (string, bool, error) {
+	assignment, response, err := a.client.GetTrustedProfileAssignmentWithContext(ctx, &iamidentityv1.GetTrustedProfileAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return normalizeAccountFactoryAssignmentStatus(assignment.Status), true, nil
+}
+
+type accessGroupAssigner struct {
+	client *iamaccessgroupsv2.IamAccessGroupsV2
+}
+
+func (a *accessGroupAssigner) create(ctx context.Context, template accountFactoryTemplate, accountID string) (string, error) {
+	options := &iamaccessgroupsv2.CreateAssignmentOptions{}
+	options.SetTemplateID(template.ID)
+	options.SetTemplateVersion(template.Version)
+	options.SetTargetType(accountFactoryTargetType)
+	options.SetTarget(accountID)
+	assignment, response, err := a.client.CreateAssignmentWithContext(ctx, options)
+	if err != nil {
+		return "", fmt.Errorf("CreateAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return *assignment.ID, nil
+}
+
+func (a *accessGroupAssigner) update(ctx context.Context, assignmentID string, version string) error {
+	_, response, err := a.client.GetAssignmentWithContext(ctx, &iamaccessgroupsv2.GetAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil {
+		return fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response)
+	}
+	options := &iamaccessgroupsv2.UpdateAssignmentOptions{}
+	options.SetAssignmentID(assignmentID)
+	options.SetIfMatch(response.Headers.Get("ETag"))
+	options.SetTemplateVersion(version)
+	_, response, err = a.client.UpdateAssignmentWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *accessGroupAssigner) delete(ctx context.Context, assignmentID string) error {
+	response, err := a.client.DeleteAssignmentWithContext(ctx, &iamaccessgroupsv2.DeleteAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("DeleteAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *accessGroupAssigner) status(ctx context.Context, assignmentID string) (string, bool, error) {
+	assignment, response, err := a.client.GetAssignmentWithContext(ctx, &iamaccessgroupsv2.GetAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return normalizeAccountFactoryAssignmentStatus(assignment.Status), true, nil
+}
+
+type policyAssigner struct {
+	client      *iampolicymanagementv1.IamPolicyManagementV1
+	requesterID string
+}
+
+func (a *policyAssigner) create(ctx context.Context, template accountFactoryTemplate, accountID string) (string, error) {
+	options := &iampolicymanagementv1.CreatePolicyTemplateAssignmentOptions{}
+	options.SetVersion("1.0")
+	options.SetTarget(&iampolicymanagementv1.AssignmentTargetDetails{
+		Type: core.StringPtr(accountFactoryTargetType),
+		ID:   &accountID,
+	})
+	options.SetOptions(&iampolicymanagementv1.PolicyAssignmentV1Options{
+		Root: &iampolicymanagementv1.PolicyAssignmentV1OptionsRoot{
+			RequesterID: &a.requesterID,
+		},
+	})
+	options.SetTemplates([]iampolicymanagementv1.AssignmentTemplateDetails{
+		{ID: &template.ID, Version: &template.Version},
+	})
+	collection, response, err := a.client.CreatePolicyTemplateAssignmentWithContext(ctx, options)
+	if err != nil {
+		return "", fmt.Errorf("CreatePolicyTemplateAssignmentWithContext failed %s\n%s", err, response)
+	}
+	if len(collection.Assignments) == 0 || collection.Assignments[0].ID == nil {
+		return "", fmt.Errorf("[ERROR] No assignment returned for policy template %s", template.ID)
+	}
+	return *collection.Assignments[0].ID, nil
+}
+
+func (a *policyAssigner) update(ctx context.Context, assignmentID string, version string) error {
+	_, response, err := a.client.GetPolicyAssignmentWithContext(ctx, &iampolicymanagementv1.GetPolicyAssignmentOptions{
+		AssignmentID: &assignmentID,
+		Version:      core.StringPtr("1.0"),
+	})
+	if err != nil {
+		return fmt.Errorf("GetPolicyAssignmentWithContext failed %s\n%s", err, response)
+	}
+	options := &iampolicymanagementv1.UpdatePolicyAssignmentOptions{}
+	options.SetAssignmentID(assignmentID)
+	options.SetVersion("1.0")
+	options.SetIfMatch(response.Headers.Get("ETag"))
+	options.SetTemplateVersion(version)
+	_, response, err = a.client.UpdatePolicyAssignmentWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("UpdatePolicyAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *policyAssigner) delete(ctx context.Context, assignmentID string) error {
+	response, err := a.client.DeletePolicyAssignmentWithContext(ctx, &iampolicymanagementv1.DeletePolicyAssignmentOptions{AssignmentID: &assignmentID})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("DeletePolicyAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func (a *policyAssigner) status(ctx context.Context, assignmentID string) (string, bool, error) {
+	result, response, err := a.client.GetPolicyAssignmentWithContext(ctx, &iampolicymanagementv1.GetPolicyAssignmentOptions{
+		AssignmentID: &assignmentID,
+		Version:      core.StringPtr("1.0"),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("GetPolicyAssignmentWithContext failed %s\n%s", err, response)
+	}
+	switch assignment := result.(type) {
+	case *iampolicymanagementv1.GetPolicyAssignmentResponse:
+		return normalizeAccountFactoryAssignmentStatus(assignment.Status), true, nil
+	case *iampolicymanagementv1.GetPolicyAssignmentResponsePolicyAssignmentV1:
+		return normalizeAccountFactoryAssignmentStatus(assignment.Status), true, nil
+	}
+	return accountFactoryAssignmentPending, true, nil
+}
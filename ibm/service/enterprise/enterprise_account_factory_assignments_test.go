@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortAccountFactoryTemplates(t *testing.T) {
+	templates := []accountFactoryTemplate{
+		{Type: accountFactoryTemplatePolicy, ID: "policy-1", Version: "1"},
+		{Type: accountFactoryTemplateAccessGroup, ID: "group-1", Version: "1"},
+		{Type: accountFactoryTemplatePolicy, ID: "policy-2", Version: "1"},
+		{Type: accountFactoryTemplateAccountSettings, ID: "settings", Version: "2"},
+		{Type: accountFactoryTemplateTrustedProfile, ID: "profile", Version: "1"},
+	}
+	sortAccountFactoryTemplates(templates)
+
+	ids := []string{}
+	for _, template := range templates {
+		ids = append(ids, template.ID)
+	}
+	assert.Equal(t, []string{"settings", "profile", "group-1", "policy-1", "policy-2"}, ids)
+}
+
+func TestPlanAccountFactoryAssignments(t *testing.T) {
+	templates := []accountFactoryTemplate{
+		{Type: accountFactoryTemplateAccountSettings, ID: "settings", Version: "2"},
+		{Type: accountFactoryTemplateTrustedProfile, ID: "profile", Version: "1"},
+		{Type: accountFactoryTemplateAccessGroup, ID: "group", Version: "3"},
+		{Type: accountFactoryTemplatePolicy, ID: "policy", Version: "1"},
+		{Type: accountFactoryTemplatePolicy, ID: "new", Version: "1"},
+	}
+	assignments := []accountFactoryAssignment{
+		// Moved to a new version
+		{accountFactoryTemplate: accountFactoryTemplate{Type: accountFactoryTemplateAccountSettings, ID: "settings", Version: "1"}, AssignmentID: "a1", Status: accountFactoryAssignmentSucceeded},
+		// Unchanged
+		{accountFactoryTemplate: accountFactoryTemplate{Type: accountFactoryTemplateTrustedProfile, ID: "profile", Version: "1"}, AssignmentID: "a2", Status: accountFactoryAssignmentSucceeded},
+		// Retried
+		{accountFactoryTemplate: accountFactoryTemplate{Type: accountFactoryTemplateAccessGroup, ID: "group", Version: "3"}, AssignmentID: "a3", Status: accountFactoryAssignmentFailed},
+		// Not created
+		{accountFactoryTemplate: accountFactoryTemplate{Type: accountFactoryTemplatePolicy, ID: "policy", Version: "1"}, Status: accountFactoryAssignmentFailed},
+		// Removed
+		{accountFactoryTemplate: accountFactoryTemplate{Type: accountFactoryTemplatePolicy, ID: "old", Version: "1"}, AssignmentID: "a5", Status: accountFactoryAssignmentSucceeded},
+		// Removed but never created
+		{accountFactoryTemplate: accountFactoryTemplate{Type: accountFactoryTemplatePolicy, ID: "gone", Version: "1"}, Status: accountFactoryAssignmentFailed},
+	}
+
+	create, update, remove := planAccountFactoryAssignments(templates, assignments)
+
+	assert.Equal(t, []accountFactoryTemplate{templates[3], templates[4]}, create)
+	if assert.Len(t, update, 2) {
+		assert.Equal(t, "a1", update[0].AssignmentID)
+		assert.Equal(t, "2", update[0].Version)
+		assert.Equal(t, "a3", update[1].AssignmentID)
+		assert.Equal(t, "3", update[1].Version)
+	}
+	if assert.Len(t, remove, 1) {
+		assert.Equal(t, "a5", remove[0].AssignmentID)
+	}
+}
+
+func TestAccountFactoryAssignmentsOutOfDate(t *testing.T) {
+	templates := []accountFactoryTemplate{
+		{Type: accountFactoryTemplateTrustedProfile, ID: "profile", Version: "1"},
+		{Type: accountFactoryTemplateAccessGroup, ID: "group", Version: "3"},
+	}
+	succeeded := func(template accountFactoryTemplate, assignmentID string) accountFactoryAssignment {
+		return accountFactoryAssignment{accountFactoryTemplate: template, AssignmentID: assignmentID, Status: accountFactoryAssignmentSucceeded}
+	}
+
+	assignments := []accountFactoryAssignment{succeeded(templates[0], "a1"), succeeded(templates[1], "a2")}
+	assert.False(t, accountFactoryAssignmentsOutOfDate(templates, assignments))
+
+	// The assignment of the access group template was removed outside of Terraform
+	assert.True(t, accountFactoryAssignmentsOutOfDate(templates, assignments[:1]))
+
+	// The trusted profile template is assigned in another version
+	moved := succeeded(accountFactoryTemplate{Type: accountFactoryTemplateTrustedProfile, ID: "profile", Version: "2"}, "a1")
+	assert.True(t, accountFactoryAssignmentsOutOfDate(templates, []accountFactoryAssignment{moved, assignments[1]}))
+
+	// An assignment did not succeed
+	failed := assignments[1]
+	failed.Status = accountFactoryAssignmentFailed
+	assert.True(t, accountFactoryAssignmentsOutOfDate(templates, []accountFactoryAssignment{assignments[0], failed}))
+
+	// An assignment of a removed template is removed by the update of the templates
+	assert.False(t, accountFactoryAssignmentsOutOfDate(templates[:1], assignments[:1]))
+}
+
+func TestNormalizeAccountFactoryAssignmentStatus(t *testing.T) {
+	status := func(s string) *string { return &s }
+	assert.Equal(t, accountFactoryAssignmentPending, normalizeAccountFactoryAssignmentStatus(nil))
+	assert.Equal(t, accountFactoryAssignmentPending, normalizeAccountFactoryAssignmentStatus(status("accepted")))
+	assert.Equal(t, accountFactoryAssignmentPending, normalizeAccountFactoryAssignmentStatus(status("in_progress")))
+	assert.Equal(t, accountFactoryAssignmentSucceeded, normalizeAccountFactoryAssignmentStatus(status("succeeded")))
+	assert.Equal(t, accountFactoryAssignmentFailed, normalizeAccountFactoryAssignmentStatus(status("failed")))
+	assert.Equal(t, accountFactoryAssignmentFailed, normalizeAccountFactoryAssignmentStatus(status("succeed_with_errors")))
+}
@@ -0,0 +1,676 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+const (
+	accountFactoryAccountActive  = "active"
+	accountFactoryAccountPending = "pending"
+	accountFactoryDone           = "done"
+)
+
+func ResourceIBMEnterpriseAccountFactory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmEnterpriseAccountFactoryCreate,
+		ReadContext:   resourceIbmEnterpriseAccountFactoryRead,
+		UpdateContext: resourceIbmEnterpriseAccountFactoryUpdate,
+		DeleteContext: resourceIbmEnterpriseAccountFactoryDelete,
+		CustomizeDiff: resourceIbmEnterpriseAccountFactoryCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the account. This field must have 3 - 60 characters.",
+				ValidateFunc: validate.ValidateAllowedEnterpriseNameValue(),
+			},
+			"owner_iam_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The IAM ID of the account owner, such as `IBMid-0123ABC`. The IAM ID must already exist.",
+				ValidateFunc: validate.ValidateRegexps("^IBMid\\-[A-Z,0-9]{10}$"),
+			},
+			"traits": {
+				Type:             schema.TypeSet,
+				Description:      "The traits object can be used to set properties on the child account, such as opting out of Multi-Factor Authentication or enabling enterprise IAM settings.",
+				Optional:         true,
+				DiffSuppressFunc: flex.ApplyOnce,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mfa": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "By default MFA will be enabled on a child account. To opt out, pass the traits object with the mfa field set to empty string.",
+						},
+						"enterprise_iam_managed": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "The Enterprise IAM settings property will be turned off for a newly created child account by default. You can enable this property by passing 'true' in this boolean field.",
+						},
+					},
+				},
+			},
+			"options": {
+				Type:             schema.TypeSet,
+				Description:      "Creates an IAM service ID with owner IAM policies and an API key in the child account.",
+				Optional:         true,
+				DiffSuppressFunc: flex.ApplyOnce,
+				MaxItems:         1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create_iam_service_id_with_apikey_and_owner_policies": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "By default this field is turned off for a newly created child account. You can enable this property by passing 'true' in this boolean field.",
+						},
+					},
+				},
+			},
+			"template": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The IAM template versions to assign to the account once it is active.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(accountFactoryTemplateTypes, false),
+							Description:  "The type of the template, one of `account_settings`, `trusted_profile`, `access_group` or `policy`.",
+						},
+						"template_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the template.",
+						},
+						"template_version": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The committed version of the template to assign.",
+						},
+					},
+				},
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the account.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Resource Name (CRN) of the account.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the account.",
+			},
+			"iam_service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM Service ID of the account will be used to create IAM_API_KEY with owner IAM policies.",
+			},
+			"iam_apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of IAM APIKEY which has owner IAM policies",
+			},
+			"iam_apikey": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM API KEY of the account with owner IAM policies.",
+			},
+			"assignments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The assignments of the templates to the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the template.",
+						},
+						"template_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the template.",
+						},
+						"template_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the template.",
+						},
+						"assignment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the assignment, empty when the assignment could not be created.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the assignment, one of `in_progress`, `succeeded` or `failed`.",
+						},
+					},
+				},
+			},
+			"template_status": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The status of the assignment of each template, keyed by `<type>/<template_id>`.",
+			},
+		},
+	}
+}
+
+func resourceIbmEnterpriseAccountFactoryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createAccountOptions := &enterprisemanagementv1.CreateAccountOptions{}
+	createAccountOptions.SetParent(d.Get("parent").(string))
+	createAccountOptions.SetName(d.Get("name").(string))
+	createAccountOptions.SetOwnerIamID(d.Get("owner_iam_id").(string))
+	if traits, ok := d.GetOk("traits"); ok {
+		createAccountOptions.SetTraits(expandTraiits(traits.(*schema.Set).List()))
+	}
+	if options, ok := d.GetOk("options"); ok {
+		createAccountOptions.SetOptions(expandOptions(options.(*schema.Set).List()))
+	}
+	createAccountResponse, response, err := enterpriseManagementClient.CreateAccountWithContext(context, createAccountOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateAccountWithContext failed %s\n%s", err, response))
+	}
+	d.SetId(*createAccountResponse.AccountID)
+	if createAccountResponse.IamServiceID != nil && *createAccountResponse.IamServiceID != "" {
+		d.Set("iam_service_id", *createAccountResponse.IamServiceID)
+	}
+	if createAccountResponse.IamApikeyID != nil && *createAccountResponse.IamApikeyID != "" {
+		d.Set("iam_apikey_id", *createAccountResponse.IamApikeyID)
+	}
+	if createAccountResponse.IamApikey != nil && *createAccountResponse.IamApikey != "" {
+		d.Set("iam_apikey", *createAccountResponse.IamApikey)
+	}
+
+	if err = waitForEnterpriseAccountActive(context, d.Id(), meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for account %s to become active: %s", d.Id(), err))
+	}
+
+	// A failed assignment does not fail the creation, which would taint and destroy the account. It is reported as a
+	// warning and assigned again by the next apply
+	var diags diag.Diagnostics
+	assignments, err := applyAccountFactoryTemplates(context, d, meta, nil, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The templates were not all assigned to account %s", d.Id()),
+			Detail:   err.Error(),
+		})
+	}
+	diags = append(diags, accountFactoryAssignmentWarnings(assignments)...)
+
+	return append(diags, resourceIbmEnterpriseAccountFactoryRead(context, d, meta)...)
+}
+
+func resourceIbmEnterpriseAccountFactoryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+	getAccountOptions.SetAccountID(d.Id())
+	account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAccountWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("parent", account.Parent); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting parent: %s", err))
+	}
+	if err = d.Set("name", account.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("owner_iam_id", account.OwnerIamID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting owner_iam_id: %s", err))
+	}
+	if err = d.Set("account_id", account.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("url", account.URL); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting url: %s", err))
+	}
+	if err = d.Set("crn", account.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("state", account.State); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting state: %s", err))
+	}
+
+	// Refresh the status of the assignments and drop the assignments removed outside of Terraform, the custom diff
+	// then plans to assign their templates again
+	assignments := []accountFactoryAssignment{}
+	for _, assignment := range accountFactoryAssignmentsFromState(d) {
+		if assignment.AssignmentID != "" {
+			assigner, err := newAccountFactoryAssigner(meta, assignment.Type)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			status, found, err := assigner.status(context, assignment.AssignmentID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !found {
+				continue
+			}
+			assignment.Status = status
+		}
+		assignments = append(assignments, assignment)
+	}
+	if err = setAccountFactoryAssignments(d, assignments); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceIbmEnterpriseAccountFactoryUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("parent") {
+		enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateAccountOptions := &enterprisemanagementv1.UpdateAccountOptions{}
+		updateAccountOptions.SetAccountID(d.Id())
+		updateAccountOptions.SetParent(d.Get("parent").(string))
+		response, err := enterpriseManagementClient.UpdateAccountWithContext(context, updateAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateAccountWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	// The assignments are computed, their planned value is unknown when they change
+	o, _ := d.GetChange("assignments")
+	assignments, err := applyAccountFactoryTemplates(context, d, meta, accountFactoryAssignmentsFromList(o.([]interface{})), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, assignment := range assignments {
+		if assignment.Status != accountFactoryAssignmentSucceeded {
+			return diag.FromErr(fmt.Errorf("[ERROR] The assignment of %s template %s version %s to account %s did not succeed", assignment.Type, assignment.ID, assignment.Version, d.Id()))
+		}
+	}
+
+	return resourceIbmEnterpriseAccountFactoryRead(context, d, meta)
+}
+
+func resourceIbmEnterpriseAccountFactoryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removing the account from the enterprise does not remove what the templates created in it, the assignments are
+	// removed first in the reverse order of their creation
+	assignments := accountFactoryAssignmentsFromState(d)
+	for i := len(assignments) - 1; i >= 0; i-- {
+		if assignments[i].AssignmentID == "" {
+			continue
+		}
+		if err = removeAccountFactoryAssignment(context, meta, assignments[i], d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	deleteAccountOptions := &enterprisemanagementv1.DeleteAccountOptions{}
+	deleteAccountOptions.SetAccountID(d.Id())
+	response, err := enterpriseManagementClient.DeleteAccountWithContext(context, deleteAccountOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteAccountWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIbmEnterpriseAccountFactoryCustomizeDiff plans an update when the templates change, a template has no
+// assignment or an assignment did not succeed, so that the next apply assigns the templates again
+func resourceIbmEnterpriseAccountFactoryCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	templates := accountFactoryTemplatesFromList(diff.Get("template").([]interface{}))
+	assignments := accountFactoryAssignmentsFromList(diff.Get("assignments").([]interface{}))
+	if diff.HasChange("template") || accountFactoryAssignmentsOutOfDate(templates, assignments) {
+		if err := diff.SetNewComputed("assignments"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("template_status")
+	}
+	return nil
+}
+
+// applyAccountFactoryTemplates brings the assignments of the account in line with the configured templates. The
+// templates of a type are assigned once those of the previous types succeeded, a failed assignment stops the apply
+// with an error. The assignments are saved to the state as they are created so that a failure does not lose them
+func applyAccountFactoryTemplates(context context.Context, d *schema.ResourceData, meta interface{}, current []accountFactoryAssignment, timeout time.Duration) ([]accountFactoryAssignment, error) {
+	templates := accountFactoryTemplatesFromConfig(d)
+	create, update, remove := planAccountFactoryAssignments(templates, current)
+
+	assignments := map[string]*accountFactoryAssignment{}
+	for i := range current {
+		assignments[current[i].key()] = &current[i]
+	}
+	result := func() []accountFactoryAssignment {
+		list := []accountFactoryAssignment{}
+		for _, t := range templates {
+			if a, ok := assignments[t.key()]; ok {
+				list = append(list, *a)
+			}
+		}
+		for _, a := range remove {
+			if _, ok := assignments[a.key()]; ok {
+				list = append(list, *assignments[a.key()])
+			}
+		}
+		return list
+	}
+	save := func() error {
+		return setAccountFactoryAssignments(d, result())
+	}
+
+	for i := len(remove) - 1; i >= 0; i-- {
+		if err := removeAccountFactoryAssignment(context, meta, remove[i], timeout); err != nil {
+			save()
+			return result(), err
+		}
+		delete(assignments, remove[i].key())
+	}
+
+	for _, templateType := range accountFactoryTemplateTypes {
+		pending := []*accountFactoryAssignment{}
+		for _, a := range update {
+			if a.Type != templateType {
+				continue
+			}
+			assigner, err := newAccountFactoryAssigner(meta, templateType)
+			if err != nil {
+				return result(), err
+			}
+			if err = assigner.update(context, a.AssignmentID, a.Version); err != nil {
+				save()
+				return result(), err
+			}
+			assignment := assignments[a.key()]
+			assignment.Version = a.Version
+			assignment.Status = accountFactoryAssignmentPending
+			pending = append(pending, assignment)
+		}
+		for _, t := range create {
+			if t.Type != templateType {
+				continue
+			}
+			assigner, err := newAccountFactoryAssigner(meta, templateType)
+			if err != nil {
+				return result(), err
+			}
+			assignment := &accountFactoryAssignment{accountFactoryTemplate: t, Status: accountFactoryAssignmentFailed}
+			assignments[t.key()] = assignment
+			assignmentID, err := assigner.create(context, t, d.Id())
+			if err != nil {
+				save()
+				return result(), err
+			}
+			assignment.AssignmentID = assignmentID
+			assignment.Status = accountFactoryAssignmentPending
+			pending = append(pending, assignment)
+		}
+		if len(pending) == 0 {
+			continue
+		}
+		if err := save(); err != nil {
+			return result(), err
+		}
+		if err := waitForAccountFactoryAssignments(context, meta, pending, timeout); err != nil {
+			save()
+			return result(), err
+		}
+		if err := save(); err != nil {
+			return result(), err
+		}
+		// the templates of the next types build on these ones, so they are not assigned over a failure
+		for _, assignment := range pending {
+			if assignment.Status != accountFactoryAssignmentSucceeded {
+				return result(), fmt.Errorf("[ERROR] The assignment of %s template %s version %s to account %s did not succeed, the templates of the next types are not assigned", assignment.Type, assignment.ID, assignment.Version, d.Id())
+			}
+		}
+	}
+	return result(), nil
+}
+
+func removeAccountFactoryAssignment(context context.Context, meta interface{}, assignment accountFactoryAssignment, timeout time.Duration) error {
+	assigner, err := newAccountFactoryAssigner(meta, assignment.Type)
+	if err != nil {
+		return err
+	}
+	if err = assigner.delete(context, assignment.AssignmentID); err != nil {
+		return err
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accountFactoryAssignmentPending},
+		Target:  []string{accountFactoryDone},
+		Refresh: func() (interface{}, string, error) {
+			_, found, err := assigner.status(context, assignment.AssignmentID)
+			if err != nil {
+				return nil, "", err
+			}
+			if found {
+				return assignment, accountFactoryAssignmentPending, nil
+			}
+			return assignment, accountFactoryDone, nil
+		},
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      timeout,
+	}
+	if _, err = stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the assignment %s of %s template %s to be removed: %s", assignment.AssignmentID, assignment.Type, assignment.ID, err)
+	}
+	return nil
+}
+
+// waitForAccountFactoryAssignments waits until none of the assignments is in progress and updates their status
+func waitForAccountFactoryAssignments(context context.Context, meta interface{}, assignments []*accountFactoryAssignment, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accountFactoryAssignmentPending},
+		Target:  []string{accountFactoryDone},
+		Refresh: func() (interface{}, string, error) {
+			state := accountFactoryDone
+			for _, assignment := range assignments {
+				if assignment.Status != accountFactoryAssignmentPending {
+					continue
+				}
+				assigner, err := newAccountFactoryAssigner(meta, assignment.Type)
+				if err != nil {
+					return nil, "", err
+				}
+				status, found, err := assigner.status(context, assignment.AssignmentID)
+				if err != nil {
+					return nil, "", err
+				}
+				if !found {
+					return nil, "", fmt.Errorf("[ERROR] The assignment %s of %s template %s was not found", assignment.AssignmentID, assignment.Type, assignment.ID)
+				}
+				assignment.Status = status
+				if status == accountFactoryAssignmentPending {
+					state = accountFactoryAssignmentPending
+				}
+			}
+			return assignments, state, nil
+		},
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      timeout,
+	}
+	_, err := stateConf.WaitForStateContext(context)
+	return err
+}
+
+func waitForEnterpriseAccountActive(context context.Context, accountID string, meta interface{}, timeout time.Duration) error {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return err
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accountFactoryAccountPending},
+		Target:  []string{accountFactoryAccountActive},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				// The account can take a moment to be visible after its creation
+				if response != nil && response.StatusCode == 404 {
+					return nil, accountFactoryAccountPending, nil
+				}
+				return nil, "", fmt.Errorf("GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.State != nil && strings.EqualFold(*account.State, accountFactoryAccountActive) {
+				return account, accountFactoryAccountActive, nil
+			}
+			return account, accountFactoryAccountPending, nil
+		},
+		Delay:        10 * time.Second,
+		PollInterval: 30 * time.Second,
+		Timeout:      timeout,
+	}
+	_, err = stateConf.WaitForStateContext(context)
+	return err
+}
+
+func accountFactoryAssignmentWarnings(assignments []accountFactoryAssignment) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, assignment := range assignments {
+		if assignment.Status == accountFactoryAssignmentFailed {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The assignment of %s template %s version %s failed", assignment.Type, assignment.ID, assignment.Version),
+				Detail:   "The template is assigned again by the next apply.",
+			})
+		}
+	}
+	return diags
+}
+
+func accountFactoryTemplatesFromConfig(d *schema.ResourceData) []accountFactoryTemplate {
+	return accountFactoryTemplatesFromList(d.Get("template").([]interface{}))
+}
+
+func accountFactoryTemplatesFromList(list []interface{}) []accountFactoryTemplate {
+	templates := []accountFactoryTemplate{}
+	for _, t := range list {
+		if t == nil {
+			continue
+		}
+		m := t.(map[string]interface{})
+		templates = append(templates, accountFactoryTemplate{
+			Type:    m["type"].(string),
+			ID:      m["template_id"].(string),
+			Version: m["template_version"].(string),
+		})
+	}
+	sortAccountFactoryTemplates(templates)
+	return templates
+}
+
+func accountFactoryAssignmentsFromState(d *schema.ResourceData) []accountFactoryAssignment {
+	return accountFactoryAssignmentsFromList(d.Get("assignments").([]interface{}))
+}
+
+func accountFactoryAssignmentsFromList(list []interface{}) []accountFactoryAssignment {
+	assignments := []accountFactoryAssignment{}
+	for _, a := range list {
+		if a == nil {
+			continue
+		}
+		m := a.(map[string]interface{})
+		assignments = append(assignments, accountFactoryAssignment{
+			accountFactoryTemplate: accountFactoryTemplate{
+				Type:    m["type"].(string),
+				ID:      m["template_id"].(string),
+				Version: m["template_version"].(string),
+			},
+			AssignmentID: m["assignment_id"].(string),
+			Status:       m["status"].(string),
+		})
+	}
+	return assignments
+}
+
+func setAccountFactoryAssignments(d *schema.ResourceData, assignments []accountFactoryAssignment) error {
+	list := []map[string]interface{}{}
+	status := map[string]string{}
+	for _, assignment := range assignments {
+		list = append(list, map[string]interface{}{
+			"type":             assignment.Type,
+			"template_id":      assignment.ID,
+			"template_version": assignment.Version,
+			"assignment_id":    assignment.AssignmentID,
+			"status":           assignment.Status,
+		})
+		status[assignment.key()] = assignment.Status
+	}
+	if err := d.Set("assignments", list); err != nil {
+		return fmt.Errorf("[ERROR] Error setting assignments: %s", err)
+	}
+	if err := d.Set("template_status", status); err != nil {
+		return fmt.Errorf("[ERROR] Error setting template_status: %s", err)
+	}
+	return nil
+}
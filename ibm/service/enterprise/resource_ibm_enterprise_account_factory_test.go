@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise with enterprise IAM enabled" */
+func TestAccIbmEnterpriseAccountFactoryBasic(t *testing.T) {
+	accountName := fmt.Sprintf("tf-gen-account-name_%d", acctest.RandIntRange(10, 100))
+	templateName := fmt.Sprintf("tf-account-factory-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEnterpriseAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseAccountFactoryConfigBasic(accountName, templateName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "name", accountName),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account_factory.account", "state"),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account_factory.account", "account_id"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "assignments.#", "2"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "assignments.0.type", "account_settings"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "assignments.0.status", "succeeded"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "assignments.1.type", "access_group"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "assignments.1.status", "succeeded"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_factory.account", "template_status.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmEnterpriseAccountFactoryConfigBasic(accountName string, templateName string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_iam_account_settings_template" "settings" {
+			name = "%[2]s"
+			committed = true
+			account_settings {
+				restrict_create_service_id = "RESTRICTED"
+				restrict_create_platform_apikey = "RESTRICTED"
+				mfa = "NONE"
+			}
+		}
+		resource "ibm_iam_access_group_template" "access_group" {
+			name = "%[2]s"
+			group {
+				name = "%[2]s"
+			}
+			committed = true
+		}
+		resource "ibm_enterprise_account_factory" "account" {
+			parent = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name = "%[1]s"
+			owner_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+			traits {
+				enterprise_iam_managed = true
+			}
+			template {
+				type = "access_group"
+				template_id = ibm_iam_access_group_template.access_group.template_id
+				template_version = ibm_iam_access_group_template.access_group.version
+			}
+			template {
+				type = "account_settings"
+				template_id = split("/", ibm_iam_account_settings_template.settings.id)[0]
+				template_version = ibm_iam_account_settings_template.settings.version
+			}
+		}
+	`, accountName, templateName)
+}
@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const resourceSearchPageSize = 1000
+
+func DataSourceIBMResourceSearch() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMResourceSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Lucene-formatted query string, for example `family:resource_controller AND region:us-south`",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional fields to return in the properties of the items, `*` returns all the fields",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limits the search to the resources of a resource group",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limits the search to the resources of an account",
+			},
+			"include_deleted": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether deleted resources are returned along with the existing ones",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of resources found",
+			},
+			"crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the resources found",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources found",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The family of the resource, for example resource_controller or ims",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the resource",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the resource",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account of the resource",
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service of the resource",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags of the resource",
+						},
+						"access_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The access tags of the resource",
+						},
+						"service_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The service tags of the resource",
+						},
+						"properties": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The other requested fields, values that are not strings are in JSON format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceSearchRead(d *schema.ResourceData, meta interface{}) error {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	query := d.Get("query").(string)
+	if rg, ok := d.GetOk("resource_group_id"); ok {
+		query = fmt.Sprintf("(%s) AND resource_group_id:%s", query, rg.(string))
+	}
+	fields := []string{}
+	if f, ok := d.GetOk("fields"); ok {
+		for _, field := range f.([]interface{}) {
+			if s, ok := field.(string); ok && s != "" {
+				fields = append(fields, s)
+			}
+		}
+	}
+
+	options := globalsearchv2.SearchOptions{}
+	options.SetQuery(query)
+	options.SetFields(resourceSearchRequestFields(fields))
+	options.SetLimit(resourceSearchPageSize)
+	if account, ok := d.GetOk("account_id"); ok {
+		options.SetAccountID(account.(string))
+	}
+	if d.Get("include_deleted").(bool) {
+		options.SetIsDeleted(globalsearchv2.SearchOptionsIsDeletedAnyConst)
+	}
+
+	items := []interface{}{}
+	crns := []string{}
+	for {
+		result, resp, err := gsClient.Search(&options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error searching resources with query %s: %s %s", query, err, resp)
+		}
+		for _, item := range result.Items {
+			properties := map[string]interface{}{}
+			for k, v := range item.GetProperties() {
+				properties[k] = v
+			}
+			if item.CRN != nil {
+				properties["crn"] = *item.CRN
+			}
+			flattened := flattenResourceSearchItem(properties)
+			items = append(items, flattened)
+			crns = append(crns, flattened["crn"].(string))
+		}
+		if len(result.Items) == 0 || result.SearchCursor == nil {
+			break
+		}
+		options.SearchCursor = core.StringPtr(*result.SearchCursor)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("items", items); err != nil {
+		return fmt.Errorf("[ERROR] Error setting items: %s", err)
+	}
+	d.Set("crns", crns)
+	d.Set("total", len(items))
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSearchDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-search-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceSearchDataSource(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_search.search", "total", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_resource_search.search", "items.0.crn", "ibm_resource_instance.instance", "crn"),
+					resource.TestCheckResourceAttr("data.ibm_resource_search.search", "items.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_resource_search.search", "items.0.family", "resource_controller"),
+					resource.TestCheckResourceAttr("data.ibm_resource_search.search", "items.0.tags.#", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_search.search", "items.0.properties.doc"),
+					resource.TestCheckResourceAttrPair("data.ibm_resource_search.search", "crns.0", "ibm_resource_instance.instance", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceSearchDataSource(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+		tags     = ["%s"]
+	}
+
+	data "ibm_resource_search" "search" {
+		query  = "name:${ibm_resource_instance.instance.name} AND tags:\"${tolist(ibm_resource_instance.instance.tags)[0]}\""
+		fields = ["doc.state"]
+	}
+`, name, name)
+}
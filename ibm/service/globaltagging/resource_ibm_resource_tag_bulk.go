@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	resourceTagBulkMaxBatchSize = 100
+	resourceTagBulkCRNsPerQuery = 50
+)
+
+// resourceTagBulkTagTypes maps the tag arguments to the tag type of the API and the field of the search results
+var resourceTagBulkTagTypes = []struct{ attribute, tagType, field string }{
+	{"tags", "user", "tags"},
+	{"access_tags", "access", "access_tags"},
+}
+
+func ResourceIBMResourceTagBulk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMResourceTagBulkCreate,
+		ReadContext:   resourceIBMResourceTagBulkRead,
+		UpdateContext: resourceIBMResourceTagBulkUpdate,
+		DeleteContext: resourceIBMResourceTagBulkDelete,
+
+		CustomizeDiff: resourceIBMResourceTagBulkCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"resource_crns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_tag_bulk", "resource_crns")},
+				ExactlyOneOf: []string{"resource_crns", "query"},
+				Description:  "The CRNs of the resources on which the tags are managed",
+			},
+			"query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"resource_crns", "query"},
+				Description:  "The Lucene-formatted Global Search query that matches the resources on which the tags are managed",
+			},
+			"tags": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_tag_bulk", "tags")},
+				Set:          flex.ResourceIBMVPCHash,
+				AtLeastOneOf: []string{"tags", "access_tags"},
+				Description:  "The user tags attached to the resources, the other values of their keys are detached",
+			},
+			"access_tags": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_resource_tag_bulk", "tags")},
+				Set:          flex.ResourceIBMVPCHash,
+				AtLeastOneOf: []string{"tags", "access_tags"},
+				Description:  "The access tags attached to the resources, the other values of their keys are detached",
+			},
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      resourceTagBulkMaxBatchSize,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, resourceTagBulkMaxBatchSize),
+				Description:  "The number of resources tagged by each request",
+			},
+			"matched_resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the resources on which the tags are managed",
+			},
+			"pending_resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the resources that miss a tag or still have another value of a tag key",
+			},
+		},
+	}
+}
+
+func ResourceIBMResourceTagBulkValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "resource_crns",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^crn:v1(:[a-zA-Z0-9 \-\._~\*\+,;=!$&'\(\)\/\?#\[\]@]*){8}$`,
+			MinValueLength:             1,
+			MaxValueLength:             1024})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "tags",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128})
+
+	ibmResourceTagBulkValidator := validate.ResourceValidator{ResourceName: "ibm_resource_tag_bulk", Schema: validateSchema}
+	return &ibmResourceTagBulkValidator
+}
+
+// resourceIBMResourceTagBulkCustomizeDiff plans an update when resources miss tags, for example resources that
+// started to match the query since the last apply
+func resourceIBMResourceTagBulkCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || len(diff.Get("pending_resources").([]interface{})) == 0 {
+		return nil
+	}
+	if err := diff.SetNewComputed("matched_resources"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("pending_resources")
+}
+
+func resourceIBMResourceTagBulkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	targets, err := resourceIBMResourceTagBulkTargets(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	// The resources that could not be tagged are pending and tagged again by the next apply
+	if err := resourceIBMResourceTagBulkApply(d, meta, targets); err != nil {
+		diags := diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The tags were not applied to all the resources",
+			Detail:   err.Error(),
+		}}
+		return append(diags, resourceIBMResourceTagBulkRead(context, d, meta)...)
+	}
+	return resourceIBMResourceTagBulkSetApplied(d, targets)
+}
+
+func resourceIBMResourceTagBulkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	targets, err := resourceIBMResourceTagBulkTargets(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matched := []string{}
+	pending := map[string]bool{}
+	for _, target := range targets {
+		matched = append(matched, target.CRN)
+	}
+	for _, tagType := range resourceTagBulkTagTypes {
+		desired := flex.ExpandStringList(d.Get(tagType.attribute).(*schema.Set).List())
+		attach, detach := resourceTagBulkPlan(desired, targets, tagType.tagType)
+		for _, operation := range append(detach, attach...) {
+			for _, crn := range operation.Resources {
+				pending[crn] = true
+			}
+		}
+	}
+	pendingList := []string{}
+	for _, crn := range matched {
+		if pending[crn] {
+			pendingList = append(pendingList, crn)
+		}
+	}
+
+	if err = d.Set("matched_resources", matched); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting matched_resources: %s", err))
+	}
+	if err = d.Set("pending_resources", pendingList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting pending_resources: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMResourceTagBulkUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	targets, err := resourceIBMResourceTagBulkTargets(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matched := map[string]bool{}
+	for _, target := range targets {
+		matched[target.CRN] = true
+	}
+
+	// The matched resources are unknown in the plan when resources are pending
+	o, _ := d.GetChange("matched_resources")
+	previous := flex.ExpandStringList(o.([]interface{}))
+	for _, tagType := range resourceTagBulkTagTypes {
+		oldTags, newTags := d.GetChange(tagType.attribute)
+		release := resourceTagBulkRelease(
+			flex.ExpandStringList(oldTags.(*schema.Set).List()),
+			flex.ExpandStringList(newTags.(*schema.Set).List()),
+			previous, matched)
+		if err := resourceIBMResourceTagBulkRun(d, meta, "detach", tagType.tagType, release); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := resourceIBMResourceTagBulkApply(d, meta, targets); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMResourceTagBulkSetApplied(d, targets)
+}
+
+// resourceIBMResourceTagBulkSetApplied sets the state after the tags were applied to all the resources, Global Search
+// takes a while to index the new tags so they are not read back
+func resourceIBMResourceTagBulkSetApplied(d *schema.ResourceData, targets []resourceTagBulkTarget) diag.Diagnostics {
+	matched := []string{}
+	for _, target := range targets {
+		matched = append(matched, target.CRN)
+	}
+	if err := d.Set("matched_resources", matched); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting matched_resources: %s", err))
+	}
+	if err := d.Set("pending_resources", []string{}); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting pending_resources: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMResourceTagBulkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resources := flex.ExpandStringList(d.Get("matched_resources").([]interface{}))
+	for _, tagType := range resourceTagBulkTagTypes {
+		tagNames := flex.ExpandStringList(d.Get(tagType.attribute).(*schema.Set).List())
+		if len(tagNames) == 0 || len(resources) == 0 {
+			continue
+		}
+		operation := resourceTagBulkOperation{TagNames: tagNames, Resources: resources}
+		if err := resourceIBMResourceTagBulkRun(d, meta, "detach", tagType.tagType, []resourceTagBulkOperation{operation}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMResourceTagBulkApply attaches the tags to the resources that miss them, then detaches the other values of
+// their keys, so that the resources always have a value of each key
+func resourceIBMResourceTagBulkApply(d *schema.ResourceData, meta interface{}, targets []resourceTagBulkTarget) error {
+	for _, tagType := range resourceTagBulkTagTypes {
+		desired := flex.ExpandStringList(d.Get(tagType.attribute).(*schema.Set).List())
+		attach, detach := resourceTagBulkPlan(desired, targets, tagType.tagType)
+		if err := resourceIBMResourceTagBulkRun(d, meta, "attach", tagType.tagType, attach); err != nil {
+			return err
+		}
+		if err := resourceIBMResourceTagBulkRun(d, meta, "detach", tagType.tagType, detach); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceIBMResourceTagBulkRun sends the operations in batches of resources and reports the resources that still
+// failed after the retries
+func resourceIBMResourceTagBulkRun(d *schema.ResourceData, meta interface{}, action, tagType string, operations []resourceTagBulkOperation) error {
+	if len(operations) == 0 {
+		return nil
+	}
+	gtClient, err := meta.(conns.ClientSession).GlobalTaggingAPIv1()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting global tagging client settings: %s", err)
+	}
+	batchSize := d.Get("batch_size").(int)
+
+	for _, operation := range operations {
+		send := func(batch []string) ([]string, error) {
+			resources := make([]globaltaggingv1.Resource, 0, len(batch))
+			for _, crn := range batch {
+				resources = append(resources, globaltaggingv1.Resource{ResourceID: core.StringPtr(crn)})
+			}
+			var result *globaltaggingv1.TagResults
+			var resp *core.DetailedResponse
+			var err error
+			if action == "attach" {
+				result, resp, err = gtClient.AttachTag(&globaltaggingv1.AttachTagOptions{
+					Resources: resources,
+					TagNames:  operation.TagNames,
+					TagType:   core.StringPtr(tagType),
+				})
+			} else {
+				result, resp, err = gtClient.DetachTag(&globaltaggingv1.DetachTagOptions{
+					Resources: resources,
+					TagNames:  operation.TagNames,
+					TagType:   core.StringPtr(tagType),
+				})
+			}
+			if err != nil {
+				return batch, fmt.Errorf("%s\n%s", err, resp)
+			}
+			return resourceTagBulkFailedResources(result), nil
+		}
+
+		failed, err := resourceTagBulkSend(operation.Resources, batchSize, send)
+		if len(failed) > 0 {
+			return fmt.Errorf("[ERROR] Error trying to %s %s tags %v on %d of %d resources: %s\nFailed resources: %s",
+				action, tagType, operation.TagNames, len(failed), len(operation.Resources), err, strings.Join(failed, ", "))
+		}
+	}
+	return nil
+}
+
+func resourceTagBulkFailedResources(result *globaltaggingv1.TagResults) []string {
+	failed := []string{}
+	if result == nil {
+		return failed
+	}
+	for _, item := range result.Results {
+		if item.IsError != nil && *item.IsError && item.ResourceID != nil {
+			failed = append(failed, *item.ResourceID)
+		}
+	}
+	return failed
+}
+
+// resourceIBMResourceTagBulkTargets returns the resources of resource_crns or matched by query, with their tags
+func resourceIBMResourceTagBulkTargets(d *schema.ResourceData, meta interface{}) ([]resourceTagBulkTarget, error) {
+	gsClient, err := meta.(conns.ClientSession).GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting global search client settings: %s", err)
+	}
+
+	if query, ok := d.GetOk("query"); ok {
+		return resourceTagBulkSearch(&gsClient, query.(string))
+	}
+
+	crns := flex.ExpandStringList(d.Get("resource_crns").(*schema.Set).List())
+	sort.Strings(crns)
+	found := map[string]resourceTagBulkTarget{}
+	for start := 0; start < len(crns); start += resourceTagBulkCRNsPerQuery {
+		end := start + resourceTagBulkCRNsPerQuery
+		if end > len(crns) {
+			end = len(crns)
+		}
+		clauses := []string{}
+		for _, crn := range crns[start:end] {
+			clauses = append(clauses, fmt.Sprintf("crn:\"%s\"", crn))
+		}
+		targets, err := resourceTagBulkSearch(&gsClient, strings.Join(clauses, " OR "))
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range targets {
+			found[target.CRN] = target
+		}
+	}
+
+	// Resources that are not indexed by Global Search yet are tagged as if they had no tags
+	targets := make([]resourceTagBulkTarget, 0, len(crns))
+	for _, crn := range crns {
+		if target, ok := found[crn]; ok {
+			targets = append(targets, target)
+		} else {
+			targets = append(targets, resourceTagBulkTarget{CRN: crn, Tags: map[string][]string{}})
+		}
+	}
+	return targets, nil
+}
+
+func resourceTagBulkSearch(gsClient *globalsearchv2.GlobalSearchV2, query string) ([]resourceTagBulkTarget, error) {
+	fields := []string{"crn"}
+	for _, tagType := range resourceTagBulkTagTypes {
+		fields = append(fields, tagType.field)
+	}
+	options := globalsearchv2.SearchOptions{}
+	options.SetQuery(query)
+	options.SetFields(fields)
+	options.SetLimit(resourceSearchPageSize)
+
+	targets := []resourceTagBulkTarget{}
+	for {
+		result, resp, err := gsClient.Search(&options)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error searching resources with query %s: %s %s", query, err, resp)
+		}
+		for _, item := range result.Items {
+			if item.CRN == nil {
+				continue
+			}
+			target := resourceTagBulkTarget{CRN: *item.CRN, Tags: map[string][]string{}}
+			for _, tagType := range resourceTagBulkTagTypes {
+				target.Tags[tagType.tagType] = resourceSearchStringList(item.GetProperty(tagType.field))
+			}
+			targets = append(targets, target)
+		}
+		if len(result.Items) == 0 || result.SearchCursor == nil {
+			break
+		}
+		options.SearchCursor = core.StringPtr(*result.SearchCursor)
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].CRN < targets[j].CRN })
+	return targets, nil
+}
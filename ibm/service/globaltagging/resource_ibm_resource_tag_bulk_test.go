@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTagBulk_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-tag-bulk-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceTagBulkCreate(name, "cost-center:1100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag_bulk.bulk", "matched_resources.#", "2"),
+					resource.TestCheckResourceAttr("ibm_resource_tag_bulk.bulk", "pending_resources.#", "0"),
+					resource.TestCheckResourceAttr("ibm_resource_tag_bulk.bulk", "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckResourceTagBulkCreate(name, "cost-center:4200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag_bulk.bulk", "matched_resources.#", "2"),
+					resource.TestCheckResourceAttr("ibm_resource_tag_bulk.bulk", "pending_resources.#", "0"),
+					resource.TestCheckTypeSetElemAttr("ibm_resource_tag_bulk.bulk", "tags.*", "cost-center:4200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagBulkCreate(name, costCenter string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		count    = 2
+		name     = "%s-${count.index}"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_resource_tag_bulk" "bulk" {
+		resource_crns = ibm_resource_instance.instance[*].crn
+		tags          = ["%s", "team:%s"]
+		batch_size    = 1
+	}
+`, name, costCenter, name)
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// resourceSearchFields are returned as typed attributes of the items, the other requested fields are in properties
+var resourceSearchFields = []string{"crn", "name", "type", "family", "region", "resource_group_id", "account_id", "service_name", "tags", "access_tags", "service_tags"}
+
+// resourceSearchRequestFields adds the fields of the typed attributes to the requested fields
+func resourceSearchRequestFields(fields []string) []string {
+	requested := map[string]bool{}
+	result := []string{}
+	for _, field := range append(append([]string{}, resourceSearchFields...), fields...) {
+		if !requested[field] {
+			requested[field] = true
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// flattenResourceSearchItem maps the fields of a search result to the typed attributes and properties of an item
+func flattenResourceSearchItem(fields map[string]interface{}) map[string]interface{} {
+	item := map[string]interface{}{}
+	typed := map[string]bool{}
+	for _, field := range resourceSearchFields {
+		typed[field] = true
+		switch field {
+		case "tags", "access_tags", "service_tags":
+			item[field] = resourceSearchStringList(fields[field])
+		default:
+			s, _ := fields[field].(string)
+			item[field] = s
+		}
+	}
+
+	properties := map[string]interface{}{}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if typed[key] || fields[key] == nil {
+			continue
+		}
+		if s, ok := fields[key].(string); ok {
+			properties[key] = s
+		} else if b, err := json.Marshal(fields[key]); err == nil {
+			properties[key] = string(b)
+		}
+	}
+	item["properties"] = properties
+	return item
+}
+
+func resourceSearchStringList(value interface{}) []string {
+	list := []string{}
+	if values, ok := value.([]interface{}); ok {
+		for _, v := range values {
+			if s, ok := v.(string); ok {
+				list = append(list, s)
+			}
+		}
+	}
+	return list
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceSearchRequestFields(t *testing.T) {
+	fields := resourceSearchRequestFields([]string{"name", "doc.state", "created_at"})
+	assert.Equal(t, append(append([]string{}, resourceSearchFields...), "doc.state", "created_at"), fields)
+}
+
+func TestFlattenResourceSearchItem(t *testing.T) {
+	item := flattenResourceSearchItem(map[string]interface{}{
+		"crn":               "crn:v1:bluemix:public:kms:us-south:a/account:instance::",
+		"name":              "kms",
+		"family":            "resource_controller",
+		"region":            "us-south",
+		"resource_group_id": "group",
+		"tags":              []interface{}{"env:prod", "team:iam"},
+		"doc":               map[string]interface{}{"state": "active"},
+		"created_at":        "2024-03-01T00:00:00Z",
+		"deleted":           nil,
+	})
+	assert.Equal(t, "crn:v1:bluemix:public:kms:us-south:a/account:instance::", item["crn"])
+	assert.Equal(t, "kms", item["name"])
+	assert.Equal(t, "resource_controller", item["family"])
+	assert.Equal(t, "us-south", item["region"])
+	assert.Equal(t, "group", item["resource_group_id"])
+	assert.Equal(t, "", item["service_name"])
+	assert.Equal(t, []string{"env:prod", "team:iam"}, item["tags"])
+	assert.Equal(t, []string{}, item["access_tags"])
+	assert.Equal(t, map[string]interface{}{
+		"doc":        `{"state":"active"}`,
+		"created_at": "2024-03-01T00:00:00Z",
+	}, item["properties"])
+}
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+const resourceTagBulkAttempts = 3
+
+// resourceTagBulkRetryDelay is the delay before the resources that failed are sent again, it grows with each attempt
+var resourceTagBulkRetryDelay = 5 * time.Second
+
+// resourceTagBulkTarget is a resource that the tags are managed on, with its current tags by tag type
+type resourceTagBulkTarget struct {
+	CRN  string
+	Tags map[string][]string
+}
+
+// resourceTagBulkOperation is a request to attach or detach the same tags on a list of resources
+type resourceTagBulkOperation struct {
+	TagNames  []string
+	Resources []string
+}
+
+// resourceTagBulkSend sends the resources in batches and retries the resources that failed, it returns the resources
+// that failed at the last attempt and the last error of a request
+func resourceTagBulkSend(resources []string, batchSize int, send func(batch []string) ([]string, error)) ([]string, error) {
+	var lastErr error
+	remaining := resources
+	for attempt := 1; attempt <= resourceTagBulkAttempts && len(remaining) > 0; attempt++ {
+		if attempt > 1 {
+			log.Printf("[DEBUG] Retrying %d resources whose tags failed, attempt %d", len(remaining), attempt)
+			time.Sleep(resourceTagBulkRetryDelay * time.Duration(attempt-1))
+		}
+		failed := []string{}
+		for start := 0; start < len(remaining); start += batchSize {
+			end := start + batchSize
+			if end > len(remaining) {
+				end = len(remaining)
+			}
+			batchFailed, err := send(remaining[start:end])
+			if err != nil {
+				lastErr = err
+			}
+			failed = append(failed, batchFailed...)
+		}
+		remaining = failed
+	}
+	return remaining, lastErr
+}
+
+// resourceTagBulkKey returns the key of a tag in key:value format, tags without a key are not exclusive
+func resourceTagBulkKey(tag string) string {
+	if i := strings.Index(tag, ":"); i > 0 {
+		return strings.ToLower(tag[:i])
+	}
+	return ""
+}
+
+// resourceTagBulkChanges returns the tags a resource misses and its tags that have the key of a desired tag with
+// another value
+func resourceTagBulkChanges(desired, current []string) (attach, detach []string) {
+	keys := map[string]bool{}
+	wanted := map[string]bool{}
+	for _, tag := range desired {
+		wanted[strings.ToLower(tag)] = true
+		if key := resourceTagBulkKey(tag); key != "" {
+			keys[key] = true
+		}
+	}
+	has := map[string]bool{}
+	for _, tag := range current {
+		has[strings.ToLower(tag)] = true
+		if !wanted[strings.ToLower(tag)] && keys[resourceTagBulkKey(tag)] {
+			detach = append(detach, tag)
+		}
+	}
+	for _, tag := range desired {
+		if !has[strings.ToLower(tag)] {
+			attach = append(attach, tag)
+		}
+	}
+	sort.Strings(detach)
+	return attach, detach
+}
+
+// resourceTagBulkPlan groups the resources that need the same tags detached into one operation, all the desired tags
+// are attached to the resources that miss any of them
+func resourceTagBulkPlan(desired []string, targets []resourceTagBulkTarget, tagType string) (attach, detach []resourceTagBulkOperation) {
+	attachTo := []string{}
+	groups := map[string]*resourceTagBulkOperation{}
+	for _, target := range targets {
+		missing, extra := resourceTagBulkChanges(desired, target.Tags[tagType])
+		if len(missing) > 0 {
+			attachTo = append(attachTo, target.CRN)
+		}
+		if len(extra) > 0 {
+			key := strings.Join(extra, ",")
+			if groups[key] == nil {
+				groups[key] = &resourceTagBulkOperation{TagNames: extra}
+			}
+			groups[key].Resources = append(groups[key].Resources, target.CRN)
+		}
+	}
+	if len(attachTo) > 0 {
+		tagNames := append([]string{}, desired...)
+		sort.Strings(tagNames)
+		attach = append(attach, resourceTagBulkOperation{TagNames: tagNames, Resources: attachTo})
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		detach = append(detach, *groups[key])
+	}
+	return attach, detach
+}
+
+// resourceTagBulkRelease detaches the tags removed from the configuration, and all the previous tags from the
+// resources that are no longer matched
+func resourceTagBulkRelease(oldTags, newTags, previous []string, matched map[string]bool) []resourceTagBulkOperation {
+	kept := map[string]bool{}
+	for _, tag := range newTags {
+		kept[strings.ToLower(tag)] = true
+	}
+	removed := []string{}
+	for _, tag := range oldTags {
+		if !kept[strings.ToLower(tag)] {
+			removed = append(removed, tag)
+		}
+	}
+	sort.Strings(removed)
+	all := append([]string{}, oldTags...)
+	sort.Strings(all)
+
+	unmatched := resourceTagBulkOperation{TagNames: all}
+	stillMatched := resourceTagBulkOperation{TagNames: removed}
+	for _, crn := range previous {
+		if !matched[crn] {
+			unmatched.Resources = append(unmatched.Resources, crn)
+		} else {
+			stillMatched.Resources = append(stillMatched.Resources, crn)
+		}
+	}
+	operations := []resourceTagBulkOperation{}
+	for _, operation := range []resourceTagBulkOperation{unmatched, stillMatched} {
+		if len(operation.TagNames) > 0 && len(operation.Resources) > 0 {
+			operations = append(operations, operation)
+		}
+	}
+	return operations
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package globaltagging
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTagBulkChanges(t *testing.T) {
+	attach, detach := resourceTagBulkChanges(
+		[]string{"cost-center:4200", "team:iam", "managed"},
+		[]string{"Cost-Center:1100", "team:iam", "env:prod", "legacy"})
+	assert.Equal(t, []string{"cost-center:4200", "managed"}, attach)
+	assert.Equal(t, []string{"Cost-Center:1100"}, detach)
+
+	attach, detach = resourceTagBulkChanges([]string{"team:IAM"}, []string{"team:iam"})
+	assert.Empty(t, attach)
+	assert.Empty(t, detach)
+}
+
+func TestResourceTagBulkPlan(t *testing.T) {
+	targets := []resourceTagBulkTarget{
+		{CRN: "crn:a", Tags: map[string][]string{"user": {"cost-center:1100"}}},
+		{CRN: "crn:b", Tags: map[string][]string{"user": {"cost-center:4200"}}},
+		{CRN: "crn:c", Tags: map[string][]string{"user": {"cost-center:1100"}, "access": {"project:x"}}},
+		{CRN: "crn:d", Tags: map[string][]string{"user": {"cost-center:1200"}}},
+		{CRN: "crn:e", Tags: map[string][]string{}},
+	}
+	attach, detach := resourceTagBulkPlan([]string{"cost-center:4200"}, targets, "user")
+	assert.Equal(t, []resourceTagBulkOperation{
+		{TagNames: []string{"cost-center:4200"}, Resources: []string{"crn:a", "crn:c", "crn:d", "crn:e"}},
+	}, attach)
+	assert.Equal(t, []resourceTagBulkOperation{
+		{TagNames: []string{"cost-center:1100"}, Resources: []string{"crn:a", "crn:c"}},
+		{TagNames: []string{"cost-center:1200"}, Resources: []string{"crn:d"}},
+	}, detach)
+
+	attach, detach = resourceTagBulkPlan([]string{}, targets, "access")
+	assert.Empty(t, attach)
+	assert.Empty(t, detach)
+}
+
+func TestResourceTagBulkRelease(t *testing.T) {
+	operations := resourceTagBulkRelease(
+		[]string{"team:iam", "cost-center:1100"},
+		[]string{"team:iam"},
+		[]string{"crn:a", "crn:b", "crn:c"},
+		map[string]bool{"crn:b": true, "crn:c": true, "crn:d": true})
+	assert.Equal(t, []resourceTagBulkOperation{
+		{TagNames: []string{"cost-center:1100", "team:iam"}, Resources: []string{"crn:a"}},
+		{TagNames: []string{"cost-center:1100"}, Resources: []string{"crn:b", "crn:c"}},
+	}, operations)
+
+	operations = resourceTagBulkRelease([]string{"team:iam"}, []string{"team:iam"}, []string{"crn:a"}, map[string]bool{"crn:a": true})
+	assert.Empty(t, operations)
+}
+
+func TestResourceTagBulkSend(t *testing.T) {
+	resourceTagBulkRetryDelay = 0
+	resources := []string{"crn:1", "crn:2", "crn:3", "crn:4", "crn:5"}
+
+	batches := [][]string{}
+	attempts := map[string]int{}
+	failed, err := resourceTagBulkSend(resources, 2, func(batch []string) ([]string, error) {
+		batches = append(batches, batch)
+		failed := []string{}
+		for _, crn := range batch {
+			attempts[crn]++
+			// crn:2 fails once, crn:5 always fails
+			if (crn == "crn:2" && attempts[crn] == 1) || crn == "crn:5" {
+				failed = append(failed, crn)
+			}
+		}
+		return failed, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"crn:5"}, failed)
+	assert.Equal(t, [][]string{
+		{"crn:1", "crn:2"}, {"crn:3", "crn:4"}, {"crn:5"},
+		{"crn:2", "crn:5"},
+		{"crn:5"},
+	}, batches)
+
+	failed, err = resourceTagBulkSend(resources[:3], 100, func(batch []string) ([]string, error) {
+		return batch, errors.New("rate limited")
+	})
+	assert.EqualError(t, err, "rate limited")
+	assert.Equal(t, resources[:3], failed)
+}
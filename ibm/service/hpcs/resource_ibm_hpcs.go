@@ -488,10 +488,11 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 	d.Set("tags", tags)
 	// Set Location
 	if instance.CRN != nil {
-		location := strings.Split(*instance.CRN, ":")
-		if len(location) > 5 {
-			d.Set("location", location[5])
+		crn, err := flex.Parse(*instance.CRN)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error parsing the CRN %s: %s", *instance.CRN, err)
 		}
+		d.Set("location", crn.Region)
 	}
 	// Set Service Plan
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	rosterFormatCSV  = "csv"
+	rosterFormatJSON = "json"
+
+	rosterMemberTypeUser    = "user"
+	rosterMemberTypeService = "service"
+	rosterMemberTypeProfile = "profile"
+)
+
+// accessGroupRosterEntry is a member of an access group in a roster. The member is the email of a user, the ID or IAM ID
+// of a service ID, or the ID or IAM ID of a trusted profile
+type accessGroupRosterEntry struct {
+	AccessGroupID string `json:"access_group_id"`
+	Member        string `json:"member"`
+	Type          string `json:"type"`
+}
+
+// parseAccessGroupRoster parses a CSV roster with an access_group_id, member and optional type header, or a JSON list of
+// objects with the same keys. The type defaults to user and duplicate entries are removed
+func parseAccessGroupRoster(roster, format string) ([]accessGroupRosterEntry, error) {
+	var entries []accessGroupRosterEntry
+	var err error
+	switch format {
+	case rosterFormatJSON:
+		err = json.Unmarshal([]byte(roster), &entries)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing JSON roster: %s", err)
+		}
+	case rosterFormatCSV:
+		entries, err = parseAccessGroupRosterCSV(roster)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("[ERROR] Unsupported roster format %s", format)
+	}
+
+	seen := map[string]bool{}
+	result := make([]accessGroupRosterEntry, 0, len(entries))
+	for i, e := range entries {
+		e.AccessGroupID = strings.TrimSpace(e.AccessGroupID)
+		e.Member = strings.TrimSpace(e.Member)
+		e.Type = strings.ToLower(strings.TrimSpace(e.Type))
+		if e.Type == "" {
+			e.Type = rosterMemberTypeUser
+		}
+		if e.AccessGroupID == "" || e.Member == "" {
+			return nil, fmt.Errorf("[ERROR] Roster entry %d must set access_group_id and member", i+1)
+		}
+		if e.Type != rosterMemberTypeUser && e.Type != rosterMemberTypeService && e.Type != rosterMemberTypeProfile {
+			return nil, fmt.Errorf("[ERROR] Roster entry %d has an unsupported type %s, supported types are user, service and profile", i+1, e.Type)
+		}
+		key := strings.ToLower(fmt.Sprintf("%s/%s/%s", e.AccessGroupID, e.Type, e.Member))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+func parseAccessGroupRosterCSV(roster string) ([]accessGroupRosterEntry, error) {
+	reader := csv.NewReader(strings.NewReader(roster))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing CSV roster: %s", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	groupColumn, ok := columns["access_group_id"]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] CSV roster header must have an access_group_id column")
+	}
+	memberColumn, ok := columns["member"]
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] CSV roster header must have a member column")
+	}
+	typeColumn, hasType := columns["type"]
+
+	entries := []accessGroupRosterEntry{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error parsing CSV roster: %s", err)
+		}
+		field := func(i int) string {
+			if i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+		entry := accessGroupRosterEntry{
+			AccessGroupID: field(groupColumn),
+			Member:        field(memberColumn),
+		}
+		if hasType {
+			entry.Type = field(typeColumn)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// accessGroupRosterGroups returns the sorted IDs of the access groups in a roster
+func accessGroupRosterGroups(entries []accessGroupRosterEntry) []string {
+	seen := map[string]bool{}
+	groups := []string{}
+	for _, e := range entries {
+		if !seen[e.AccessGroupID] {
+			seen[e.AccessGroupID] = true
+			groups = append(groups, e.AccessGroupID)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
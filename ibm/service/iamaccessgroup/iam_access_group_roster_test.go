@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAccessGroupRosterCSV(t *testing.T) {
+	roster := `# joiners and movers
+access_group_id, member, type
+AccessGroupId-1, jane@example.com,
+AccessGroupId-1, ServiceId-1234, service
+AccessGroupId-2, Profile-5678, Profile
+AccessGroupId-1, jane@example.com, user
+`
+	entries, err := parseAccessGroupRoster(roster, rosterFormatCSV)
+	assert.Nil(t, err)
+	assert.Equal(t, []accessGroupRosterEntry{
+		{AccessGroupID: "AccessGroupId-1", Member: "jane@example.com", Type: "user"},
+		{AccessGroupID: "AccessGroupId-1", Member: "ServiceId-1234", Type: "service"},
+		{AccessGroupID: "AccessGroupId-2", Member: "Profile-5678", Type: "profile"},
+	}, entries)
+	assert.Equal(t, []string{"AccessGroupId-1", "AccessGroupId-2"}, accessGroupRosterGroups(entries))
+
+	entries, err = parseAccessGroupRoster("member,access_group_id\njohn@example.com,AccessGroupId-3\n", rosterFormatCSV)
+	assert.Nil(t, err)
+	assert.Equal(t, []accessGroupRosterEntry{{AccessGroupID: "AccessGroupId-3", Member: "john@example.com", Type: "user"}}, entries)
+
+	entries, err = parseAccessGroupRoster("", rosterFormatCSV)
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestParseAccessGroupRosterJSON(t *testing.T) {
+	roster := `[
+		{"access_group_id": "AccessGroupId-1", "member": "jane@example.com"},
+		{"access_group_id": "AccessGroupId-2", "member": "iam-ServiceId-1234", "type": "service"}
+	]`
+	entries, err := parseAccessGroupRoster(roster, rosterFormatJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, []accessGroupRosterEntry{
+		{AccessGroupID: "AccessGroupId-1", Member: "jane@example.com", Type: "user"},
+		{AccessGroupID: "AccessGroupId-2", Member: "iam-ServiceId-1234", Type: "service"},
+	}, entries)
+}
+
+func TestParseAccessGroupRosterErrors(t *testing.T) {
+	_, err := parseAccessGroupRoster("member\njane@example.com\n", rosterFormatCSV)
+	assert.ErrorContains(t, err, "access_group_id column")
+
+	_, err = parseAccessGroupRoster("access_group_id,member,type\nAccessGroupId-1,jane@example.com,group\n", rosterFormatCSV)
+	assert.ErrorContains(t, err, "unsupported type group")
+
+	_, err = parseAccessGroupRoster(`[{"access_group_id": "AccessGroupId-1"}]`, rosterFormatJSON)
+	assert.ErrorContains(t, err, "must set access_group_id and member")
+
+	_, err = parseAccessGroupRoster("{", rosterFormatJSON)
+	assert.NotNil(t, err)
+
+	_, err = parseAccessGroupRoster("", "yaml")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+)
+
+// addAccessGroupAssignmentEntryDrift reports a failed resource of an assignment in a target account
+func addAccessGroupAssignmentEntryDrift(report *flex.TemplateAssignmentDriftReport, accountID, kind string, entry *iamaccessgroupsv2.AssignmentResourceEntry) bool {
+	if entry == nil {
+		return false
+	}
+	message := ""
+	if entry.Error != nil {
+		message = *entry.Error
+	}
+	if (entry.Status != nil && *entry.Status == "failed") || message != "" {
+		name := ""
+		if entry.Name != nil {
+			name = " " + *entry.Name
+		} else if entry.ID != nil {
+			name = " " + *entry.ID
+		}
+		report.Add(accountID, flex.TemplateAssignmentFailed, fmt.Sprintf("%s%s failed: %s", kind, name, message))
+		return true
+	}
+	return false
+}
+
+// accessGroupTemplateAssignmentDrift reports the target accounts whose access group failed, was deleted or differs from
+// the template version in its name, description, members or dynamic rules. The policies of the access group are only
+// reported when the assignment of their policy template failed
+func accessGroupTemplateAssignmentDrift(context context.Context, iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, assignment *iamaccessgroupsv2.TemplateAssignmentVerboseResponse) []flex.TemplateAssignmentDrift {
+	report := flex.NewTemplateAssignmentDriftReport()
+	if assignment.Status != nil && (*assignment.Status == "accepted" || *assignment.Status == InProgress) {
+		return report.List()
+	}
+
+	var template *iamaccessgroupsv2.AccessGroupResponse
+	var templateErr error
+	if assignment.TemplateID != nil && assignment.TemplateVersion != nil {
+		getTemplateVersionOptions := &iamaccessgroupsv2.GetTemplateVersionOptions{}
+		getTemplateVersionOptions.SetTemplateID(*assignment.TemplateID)
+		getTemplateVersionOptions.SetVersionNum(*assignment.TemplateVersion)
+		templateVersion, response, err := iamAccessGroupsClient.GetTemplateVersionWithContext(context, getTemplateVersionOptions)
+		if err != nil {
+			templateErr = fmt.Errorf("%s\n%s", err, response)
+		} else {
+			template = templateVersion.Group
+		}
+	}
+	for _, resource := range assignment.Resources {
+		if resource.Target == nil {
+			continue
+		}
+		accountID := *resource.Target
+		report.Add(accountID, flex.TemplateAssignmentInSync, "")
+		for i := range resource.PolicyTemplateReferences {
+			addAccessGroupAssignmentEntryDrift(report, accountID, "policy template", &resource.PolicyTemplateReferences[i])
+		}
+		if resource.Group == nil {
+			continue
+		}
+		for i := range resource.Group.Members {
+			addAccessGroupAssignmentEntryDrift(report, accountID, "member", &resource.Group.Members[i])
+		}
+		for i := range resource.Group.Rules {
+			addAccessGroupAssignmentEntryDrift(report, accountID, "rule", &resource.Group.Rules[i])
+		}
+		group := resource.Group.Group
+		if group == nil || addAccessGroupAssignmentEntryDrift(report, accountID, "access group", group) {
+			continue
+		}
+
+		accessGroupID := ""
+		if group.Resource != nil && *group.Resource != "" {
+			accessGroupID = *group.Resource
+		} else if group.ID != nil {
+			accessGroupID = *group.ID
+		}
+		if accessGroupID == "" {
+			continue
+		}
+		getAccessGroupOptions := &iamaccessgroupsv2.GetAccessGroupOptions{}
+		getAccessGroupOptions.SetAccessGroupID(accessGroupID)
+		accessGroup, response, err := iamAccessGroupsClient.GetAccessGroupWithContext(context, getAccessGroupOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				report.Add(accountID, flex.TemplateAssignmentMissing, fmt.Sprintf("access group %s was deleted", accessGroupID))
+			} else {
+				report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("access group %s could not be read: %s", accessGroupID, err))
+			}
+			continue
+		}
+		if templateErr != nil {
+			report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("the access group template could not be read: %s", templateErr))
+			continue
+		}
+		if template == nil {
+			continue
+		}
+		for _, reason := range accessGroupDriftReasons(template, accessGroup) {
+			report.Add(accountID, flex.TemplateAssignmentModified, reason)
+		}
+
+		if template.Members != nil && (len(template.Members.Users) > 0 || len(template.Members.Services) > 0) {
+			members, _, err := listAccessGroupMembers(iamAccessGroupsClient, accessGroupID, "static")
+			if err != nil {
+				report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("members of access group %s could not be read: %s", accessGroupID, err))
+			} else {
+				for _, reason := range accessGroupMemberDriftReasons(template, accessGroupID, members) {
+					report.Add(accountID, flex.TemplateAssignmentModified, reason)
+				}
+			}
+		}
+
+		if template.Assertions != nil && len(template.Assertions.Rules) > 0 {
+			listAccessGroupRulesOptions := &iamaccessgroupsv2.ListAccessGroupRulesOptions{}
+			listAccessGroupRulesOptions.SetAccessGroupID(accessGroupID)
+			rules, response, err := iamAccessGroupsClient.ListAccessGroupRulesWithContext(context, listAccessGroupRulesOptions)
+			if err != nil || rules == nil {
+				report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("rules of access group %s could not be read: %s\n%s", accessGroupID, err, response))
+			} else {
+				for _, reason := range accessGroupRuleDriftReasons(template, accessGroupID, rules.Rules) {
+					report.Add(accountID, flex.TemplateAssignmentModified, reason)
+				}
+			}
+		}
+	}
+	return report.List()
+}
+
+// accessGroupMemberDriftReasons reports the members of the template that were removed from the access group of an
+// account, members added in the account are not drift
+func accessGroupMemberDriftReasons(template *iamaccessgroupsv2.AccessGroupResponse, accessGroupID string, members []iamaccessgroupsv2.ListGroupMembersResponseMember) []string {
+	current := map[string]bool{}
+	for _, m := range members {
+		if m.IamID != nil {
+			current[*m.IamID] = true
+		}
+	}
+	reasons := []string{}
+	for _, iamID := range append(append([]string{}, template.Members.Users...), template.Members.Services...) {
+		if !current[iamID] {
+			reasons = append(reasons, fmt.Sprintf("member %s was removed from access group %s", iamID, accessGroupID))
+		}
+	}
+	return reasons
+}
+
+// accessGroupRuleDriftReasons reports the dynamic rules of the template that were removed from the access group of an
+// account or whose realm, expiration or conditions were changed. Rules are matched by name and rules added in the
+// account are not drift
+func accessGroupRuleDriftReasons(template *iamaccessgroupsv2.AccessGroupResponse, accessGroupID string, rules []iamaccessgroupsv2.Rule) []string {
+	current := map[string]iamaccessgroupsv2.Rule{}
+	for _, r := range rules {
+		if r.Name != nil {
+			current[*r.Name] = r
+		}
+	}
+	reasons := []string{}
+	for _, t := range template.Assertions.Rules {
+		if t.Name == nil {
+			continue
+		}
+		rule, ok := current[*t.Name]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("rule %s was removed from access group %s", *t.Name, accessGroupID))
+			continue
+		}
+		if t.RealmName != nil && flex.StringValue(rule.RealmName) != *t.RealmName {
+			reasons = append(reasons, fmt.Sprintf("realm_name of rule %s of access group %s is %q, the template sets %q", *t.Name, accessGroupID, flex.StringValue(rule.RealmName), *t.RealmName))
+		}
+		if t.Expiration != nil && (rule.Expiration == nil || *rule.Expiration != *t.Expiration) {
+			reasons = append(reasons, fmt.Sprintf("expiration of rule %s of access group %s is %d, the template sets %d", *t.Name, accessGroupID, flex.IntValue(rule.Expiration), *t.Expiration))
+		}
+		if !accessGroupRuleConditionsEqual(t.Conditions, rule.Conditions) {
+			reasons = append(reasons, fmt.Sprintf("conditions of rule %s of access group %s differ from the template", *t.Name, accessGroupID))
+		}
+	}
+	return reasons
+}
+
+func accessGroupRuleConditionsEqual(template []iamaccessgroupsv2.Conditions, conditions []iamaccessgroupsv2.RuleConditions) bool {
+	if len(template) != len(conditions) {
+		return false
+	}
+	current := map[string]int{}
+	for _, c := range conditions {
+		current[fmt.Sprintf("%s %s %s", flex.StringValue(c.Claim), flex.StringValue(c.Operator), flex.StringValue(c.Value))]++
+	}
+	for _, c := range template {
+		key := fmt.Sprintf("%s %s %s", flex.StringValue(c.Claim), flex.StringValue(c.Operator), flex.StringValue(c.Value))
+		if current[key] == 0 {
+			return false
+		}
+		current[key]--
+	}
+	return true
+}
+
+// accessGroupDriftReasons compares the access group of an account with the group of the template
+func accessGroupDriftReasons(template *iamaccessgroupsv2.AccessGroupResponse, accessGroup *iamaccessgroupsv2.Group) []string {
+	fields := []struct {
+		name     string
+		template *string
+		account  *string
+	}{
+		{"name", template.Name, accessGroup.Name},
+		{"description", template.Description, accessGroup.Description},
+	}
+	reasons := []string{}
+	for _, field := range fields {
+		if field.template == nil {
+			continue
+		}
+		if field.account == nil || *field.account != *field.template {
+			reasons = append(reasons, fmt.Sprintf("%s of access group %s is %q, the template sets %q", field.name, flex.StringValue(accessGroup.ID), flex.StringValue(field.account), *field.template))
+		}
+	}
+	return reasons
+}
+
+func accessGroupTemplateAssignmentStatus(context context.Context, iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, id string) (string, error) {
+	getAssignmentOptions := &iamaccessgroupsv2.GetAssignmentOptions{}
+	getAssignmentOptions.SetAssignmentID(id)
+	assignment, response, err := iamAccessGroupsClient.GetAssignmentWithContext(context, getAssignmentOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return *assignment.Status, nil
+}
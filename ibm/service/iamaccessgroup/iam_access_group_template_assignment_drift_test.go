@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessGroupDriftReasons(t *testing.T) {
+	template := &iamaccessgroupsv2.AccessGroupResponse{
+		Name:        core.StringPtr("developers"),
+		Description: core.StringPtr("Developers of the account"),
+	}
+	accessGroup := &iamaccessgroupsv2.Group{
+		ID:               core.StringPtr("AccessGroupId-1"),
+		Name:             core.StringPtr("developers"),
+		Description:      core.StringPtr("Developers of the account"),
+		CreatedByID:      core.StringPtr("iam-ServiceId-templates"),
+		LastModifiedByID: core.StringPtr("IBMid-1234567890"),
+	}
+	// Modifications by another identity that leave the group as the template sets it are not drift
+	assert.Empty(t, accessGroupDriftReasons(template, accessGroup))
+
+	accessGroup.Description = core.StringPtr("Changed by hand")
+	assert.Equal(t, []string{
+		`description of access group AccessGroupId-1 is "Changed by hand", the template sets "Developers of the account"`,
+	}, accessGroupDriftReasons(template, accessGroup))
+
+	// Fields the template does not set are ignored
+	template.Description = nil
+	assert.Empty(t, accessGroupDriftReasons(template, accessGroup))
+}
+
+func TestAddAccessGroupAssignmentEntryDrift(t *testing.T) {
+	report := flex.NewTemplateAssignmentDriftReport()
+	assert.False(t, addAccessGroupAssignmentEntryDrift(report, "account-a", "rule", &iamaccessgroupsv2.AssignmentResourceEntry{
+		ID:     core.StringPtr("rule-1"),
+		Status: core.StringPtr("succeeded"),
+		Error:  core.StringPtr(""),
+	}))
+	assert.True(t, addAccessGroupAssignmentEntryDrift(report, "account-a", "member", &iamaccessgroupsv2.AssignmentResourceEntry{
+		ID:     core.StringPtr("member-1"),
+		Name:   core.StringPtr("jane@example.com"),
+		Status: core.StringPtr("failed"),
+		Error:  core.StringPtr("user not found"),
+	}))
+
+	assert.Equal(t, []flex.TemplateAssignmentDrift{
+		{AccountID: "account-a", Status: flex.TemplateAssignmentFailed, Reasons: []string{"member jane@example.com failed: user not found"}},
+	}, report.List())
+}
+
+func TestAccessGroupMemberDriftReasons(t *testing.T) {
+	template := &iamaccessgroupsv2.AccessGroupResponse{
+		Members: &iamaccessgroupsv2.Members{
+			Users:    []string{"IBMid-1", "IBMid-2"},
+			Services: []string{"iam-ServiceId-1"},
+		},
+	}
+	members := []iamaccessgroupsv2.ListGroupMembersResponseMember{
+		{IamID: core.StringPtr("IBMid-1")},
+		{IamID: core.StringPtr("iam-ServiceId-1")},
+		// members added in the account are not drift
+		{IamID: core.StringPtr("IBMid-3")},
+	}
+	assert.Equal(t, []string{"member IBMid-2 was removed from access group AccessGroupId-1"},
+		accessGroupMemberDriftReasons(template, "AccessGroupId-1", members))
+
+	members = append(members, iamaccessgroupsv2.ListGroupMembersResponseMember{IamID: core.StringPtr("IBMid-2")})
+	assert.Empty(t, accessGroupMemberDriftReasons(template, "AccessGroupId-1", members))
+}
+
+func TestAccessGroupRuleDriftReasons(t *testing.T) {
+	template := &iamaccessgroupsv2.AccessGroupResponse{
+		Assertions: &iamaccessgroupsv2.Assertions{
+			Rules: []iamaccessgroupsv2.AssertionsRule{
+				{
+					Name:       core.StringPtr("developers"),
+					Expiration: core.Int64Ptr(12),
+					RealmName:  core.StringPtr("https://idp.example.com"),
+					Conditions: []iamaccessgroupsv2.Conditions{
+						{Claim: core.StringPtr("groups"), Operator: core.StringPtr("EQUALS"), Value: core.StringPtr("\"dev\"")},
+					},
+				},
+				{Name: core.StringPtr("admins")},
+			},
+		},
+	}
+	rules := []iamaccessgroupsv2.Rule{
+		{
+			Name:       core.StringPtr("developers"),
+			Expiration: core.Int64Ptr(12),
+			RealmName:  core.StringPtr("https://idp.example.com"),
+			Conditions: []iamaccessgroupsv2.RuleConditions{
+				{Claim: core.StringPtr("groups"), Operator: core.StringPtr("EQUALS"), Value: core.StringPtr("\"dev\"")},
+			},
+		},
+		{Name: core.StringPtr("admins")},
+		{Name: core.StringPtr("added-in-the-account")},
+	}
+	assert.Empty(t, accessGroupRuleDriftReasons(template, "AccessGroupId-1", rules))
+
+	rules[0].Expiration = core.Int64Ptr(24)
+	rules[0].Conditions[0].Value = core.StringPtr("\"ops\"")
+	assert.Equal(t, []string{
+		"expiration of rule developers of access group AccessGroupId-1 is 24, the template sets 12",
+		"conditions of rule developers of access group AccessGroupId-1 differ from the template",
+		"rule admins was removed from access group AccessGroupId-1",
+	}, accessGroupRuleDriftReasons(template, "AccessGroupId-1", rules[:1]))
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
@@ -19,6 +21,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// The access groups API accepts at most 50 members in a single add or remove request
+const accessGroupMembersBatchSize = 50
+
 func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMIAMAccessGroupMembersCreate,
@@ -26,6 +31,7 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 		UpdateContext: resourceIBMIAMAccessGroupMembersUpdate,
 		DeleteContext: resourceIBMIAMAccessGroupMembersDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMIAMAccessGroupMembersCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -56,6 +62,28 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the static members of the access group that are not managed by this resource",
+			},
+
+			"unmanaged_iam_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IAM IDs of the static members that are not managed by this resource, only set when exclusive is true",
+			},
+
+			"managed_iam_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IAM IDs of the members configured on this resource when it was last applied with exclusive set to true",
+			},
+
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -140,6 +168,17 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 		return diag.FromErr(fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse))
 	}
 
+	if d.Get("exclusive").(bool) {
+		managed := map[string]bool{}
+		for _, id := range append(append(userids, serviceids...), profileids...) {
+			managed[id] = true
+		}
+		if _, err := removeUnmanagedAccessGroupMembers(iamAccessGroupsClient, grpID, managed); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("managed_iam_ids", flattenManagedAccessGroupMembers(managed))
+	}
+
 	d.SetId(fmt.Sprintf("%s/%s", grpID, time.Now().UTC().String()))
 
 	return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
@@ -157,29 +196,13 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	}
 
 	grpID := parts[0]
-	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
-	offset := int64(0)
-	// lets fetch 100 in a single pagination
-	limit := int64(100)
-	listAccessGroupMembersOptions.SetLimit(limit)
-	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	allMembers, detailedResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID, "")
 	if err != nil {
 		if detailedResponse != nil && detailedResponse.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
-	}
-	allMembers := members.Members
-	totalMembers := flex.IntValue(members.TotalCount)
-	for len(allMembers) < totalMembers {
-		offset = offset + limit
-		listAccessGroupMembersOptions.SetOffset(offset)
-		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
-		}
-		allMembers = append(allMembers, members.Members...)
+		return diag.FromErr(err)
 	}
 
 	d.Set("access_group_id", grpID)
@@ -252,6 +275,22 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		}
 	}
 
+	// The managed members are the ones configured when the resource was last applied, ibm_ids, iam_service_ids
+	// and iam_profile_ids are refreshed below with every member of the access group
+	unmanagedIAMIDs := []string{}
+	if d.Get("exclusive").(bool) {
+		managed := map[string]bool{}
+		for _, id := range d.Get("managed_iam_ids").(*schema.Set).List() {
+			managed[id.(string)] = true
+		}
+		for _, m := range allMembers {
+			if m.IamID != nil && !managed[*m.IamID] && (m.MembershipType == nil || *m.MembershipType != "dynamic") {
+				unmanagedIAMIDs = append(unmanagedIAMIDs, *m.IamID)
+			}
+		}
+	}
+	d.Set("unmanaged_iam_ids", unmanagedIAMIDs)
+
 	d.Set("members", flex.FlattenAccessGroupMembers(allMembers, res, allrecs))
 	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
 	if len(ibmID) > 0 {
@@ -386,10 +425,32 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 		}
 	}
 
+	if d.Get("exclusive").(bool) {
+		managed, err := accessGroupMembersIAMIDs(d, meta, accountID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, err := removeUnmanagedAccessGroupMembers(iamAccessGroupsClient, grpID, managed); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("managed_iam_ids", flattenManagedAccessGroupMembers(managed))
+	}
+
 	return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
 
 }
 
+// Plan an update of an exclusive resource when members were added outside of Terraform, so that they are removed
+func resourceIBMIAMAccessGroupMembersCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("exclusive").(bool) {
+		return nil
+	}
+	if unmanaged, ok := diff.GetOk("unmanaged_iam_ids"); ok && len(unmanaged.([]interface{})) > 0 {
+		return diff.SetNewComputed("unmanaged_iam_ids")
+	}
+	return nil
+}
+
 func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
@@ -556,3 +617,103 @@ func getProfileID(id string, meta interface{}) (iamidentityv1.TrustedProfile, er
 	}
 	return *profileID, nil
 }
+
+// accessGroupMembersIAMIDs returns the IAM IDs of the users, service IDs and trusted profiles in the configuration
+func accessGroupMembersIAMIDs(d *schema.ResourceData, meta interface{}, accountID string) (map[string]bool, error) {
+	userids, err := flex.FlattenUserIds(accountID, flex.ExpandStringList(d.Get("ibm_ids").(*schema.Set).List()), meta)
+	if err != nil {
+		return nil, err
+	}
+	serviceids, err := FlattenServiceIds(flex.ExpandStringList(d.Get("iam_service_ids").(*schema.Set).List()), meta)
+	if err != nil {
+		return nil, err
+	}
+	profileids, err := FlattenProfileIds(flex.ExpandStringList(d.Get("iam_profile_ids").(*schema.Set).List()), meta)
+	if err != nil {
+		return nil, err
+	}
+	managed := map[string]bool{}
+	for _, id := range append(append(userids, serviceids...), profileids...) {
+		managed[id] = true
+	}
+	return managed, nil
+}
+
+func flattenManagedAccessGroupMembers(managed map[string]bool) []string {
+	iamIDs := make([]string, 0, len(managed))
+	for id := range managed {
+		iamIDs = append(iamIDs, id)
+	}
+	sort.Strings(iamIDs)
+	return iamIDs
+}
+
+// listAccessGroupMembers lists all the members of an access group, an empty membership type lists both static and
+// dynamic members
+func listAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID, membershipType string) ([]iamaccessgroupsv2.ListGroupMembersResponseMember, *core.DetailedResponse, error) {
+	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
+	if membershipType != "" {
+		listAccessGroupMembersOptions.SetMembershipType(membershipType)
+	}
+	offset := int64(0)
+	// lets fetch 100 in a single pagination
+	limit := int64(100)
+	listAccessGroupMembersOptions.SetLimit(limit)
+	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	if err != nil || members == nil {
+		return nil, detailedResponse, fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+	}
+	allMembers := members.Members
+	totalMembers := flex.IntValue(members.TotalCount)
+	for len(allMembers) < totalMembers && len(members.Members) > 0 {
+		offset = offset + limit
+		listAccessGroupMembersOptions.SetOffset(offset)
+		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil || members == nil {
+			return nil, detailedResponse, fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+		}
+		allMembers = append(allMembers, members.Members...)
+	}
+	return allMembers, detailedResponse, nil
+}
+
+// removeAccessGroupMembers removes members from an access group in batches of the size allowed by the API
+func removeAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string, iamIDs []string) error {
+	for start := 0; start < len(iamIDs); start += accessGroupMembersBatchSize {
+		end := start + accessGroupMembersBatchSize
+		if end > len(iamIDs) {
+			end = len(iamIDs)
+		}
+		removeMembersFromAccessGroupOptions := &iamaccessgroupsv2.RemoveMembersFromAccessGroupOptions{
+			AccessGroupID: &grpID,
+			Members:       iamIDs[start:end],
+		}
+		_, detailResponse, err := iamAccessGroupsClient.RemoveMembersFromAccessGroup(removeMembersFromAccessGroupOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error removing members from group(%s): %s. API Response: %s", grpID, err, detailResponse)
+		}
+	}
+	return nil
+}
+
+// removeUnmanagedAccessGroupMembers removes the static members of an access group that are not in managed and
+// returns their IAM IDs, members added by dynamic rules are left in place
+func removeUnmanagedAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string, managed map[string]bool) ([]string, error) {
+	members, _, err := listAccessGroupMembers(iamAccessGroupsClient, grpID, "static")
+	if err != nil {
+		return nil, err
+	}
+	unmanaged := []string{}
+	for _, m := range members {
+		if m.IamID != nil && !managed[*m.IamID] {
+			unmanaged = append(unmanaged, *m.IamID)
+		}
+	}
+	if len(unmanaged) > 0 {
+		log.Printf("[INFO] Removing unmanaged members %v from access group %s", unmanaged, grpID)
+		if err := removeAccessGroupMembers(iamAccessGroupsClient, grpID, unmanaged); err != nil {
+			return nil, err
+		}
+	}
+	return unmanaged, nil
+}
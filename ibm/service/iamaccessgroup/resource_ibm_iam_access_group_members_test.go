@@ -107,6 +107,59 @@ func TestAccIBMIAMAccessGroupMember_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupMember_Exclusive(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname1 := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	var grpID, unmanagedIAMID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "exclusive", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "unmanaged_iam_ids.#", "0"),
+					func(s *terraform.State) error {
+						grpID = s.RootModule().Resources["ibm_iam_access_group.accgroup"].Primary.ID
+						unmanagedIAMID = s.RootModule().Resources["ibm_iam_service_id.serviceID1"].Primary.Attributes["iam_id"]
+						return nil
+					},
+				),
+			},
+			{
+				// Add a member outside of Terraform, the next apply removes it
+				PreConfig: func() {
+					accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+					if err != nil {
+						t.Fatal(err)
+					}
+					membersItem, err := accClient.NewAddGroupMembersRequestMembersItem(unmanagedIAMID, "service")
+					if err != nil {
+						t.Fatal(err)
+					}
+					addMembersToAccessGroupOptions := accClient.NewAddMembersToAccessGroupOptions(grpID)
+					addMembersToAccessGroupOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{*membersItem})
+					_, _, err = accClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "unmanaged_iam_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMAccessGroupMemberDestroy(s *terraform.State) error {
 	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
@@ -301,3 +354,25 @@ func testAccCheckIBMIAMAccessGroupMemberImport(name, sname, pname string) string
 		iam_profile_ids = [ibm_iam_trusted_profile.profileID.id]
 	}`, name, sname, pname, acc.IAMUser)
 }
+
+func testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1 string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID1" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgroupmem" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		iam_service_ids = [ibm_iam_service_id.serviceID.id]
+		exclusive       = true
+	}`, name, sname, sname1)
+}
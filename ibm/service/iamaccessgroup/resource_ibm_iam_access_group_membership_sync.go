@@ -0,0 +1,638 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	accessGroupSyncActionAdd    = "add"
+	accessGroupSyncActionRemove = "remove"
+	accessGroupSyncActionInvite = "invite"
+)
+
+func ResourceIBMIAMAccessGroupMembershipSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupMembershipSyncCreate,
+		ReadContext:   resourceIBMIAMAccessGroupMembershipSyncRead,
+		UpdateContext: resourceIBMIAMAccessGroupMembershipSyncUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupMembershipSyncDelete,
+		CustomizeDiff: resourceIBMIAMAccessGroupMembershipSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"roster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The members of the access groups, as CSV with an access_group_id, member and optional type header or as a JSON list of objects with the same keys",
+			},
+
+			"roster_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      rosterFormatCSV,
+				ValidateFunc: validate.InvokeValidator("ibm_iam_access_group_membership_sync", "roster_format"),
+				Description:  "The format of the roster, csv or json",
+			},
+
+			"access_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The access groups to reconcile, defaults to the access groups in the roster. Set it to also empty access groups that have no members left in the roster",
+			},
+
+			"managed_access_group_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The access groups that are reconciled",
+			},
+
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove the static members of the access groups that are not in the roster",
+			},
+
+			"invite_missing_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Invite the users of the roster that are not in the account",
+			},
+
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The members of the roster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access group",
+						},
+						"member": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The member as given in the roster",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the member, user, service or profile",
+						},
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the member",
+						},
+					},
+				},
+			},
+
+			"invited_users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The users invited to the account by the last reconciliation",
+			},
+
+			"drift": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The changes needed to bring the access groups in line with the roster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access group",
+						},
+						"member": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The member as given in the roster, or the IAM ID of a member that is not in the roster",
+						},
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the member, empty for users that are not in the account",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The change needed, add, remove or invite",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMIAMAccessGroupMembershipSyncValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "roster_format",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "csv, json"})
+
+	iBMIAMAccessGroupMembershipSyncValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_group_membership_sync", Schema: validateSchema}
+	return &iBMIAMAccessGroupMembershipSyncValidator
+}
+
+// accessGroupRosterMember is a roster entry resolved to its IAM ID
+type accessGroupRosterMember struct {
+	accessGroupRosterEntry
+	IamID string
+}
+
+// accessGroupSyncChange is a membership change needed to bring an access group in line with the roster
+type accessGroupSyncChange struct {
+	AccessGroupID string
+	Member        string
+	IamID         string
+	MemberType    string
+	Action        string
+}
+
+func resourceIBMIAMAccessGroupMembershipSyncCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceIBMIAMAccessGroupMembershipSyncReconcile(d, meta, userDetails.UserAccount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", userDetails.UserAccount, time.Now().UTC().String()))
+
+	return resourceIBMIAMAccessGroupMembershipSyncRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupMembershipSyncRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries, groups, err := accessGroupMembershipSyncRoster(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	members, unresolved, err := resolveAccessGroupRoster(meta, userDetails.UserAccount, entries)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	changes, err := accessGroupMembershipSyncChanges(meta, groups, members, unresolved, d.Get("exclusive").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("managed_access_group_ids", groups)
+	d.Set("members", flattenAccessGroupRosterMembers(members))
+	d.Set("drift", flattenAccessGroupSyncChanges(changes))
+	return nil
+}
+
+func resourceIBMIAMAccessGroupMembershipSyncUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Remove the members of the previous roster from the access groups that are no longer reconciled
+	_, groups, err := accessGroupMembershipSyncRoster(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managed := map[string]bool{}
+	for _, g := range groups {
+		managed[g] = true
+	}
+	oldRoster, _ := d.GetChange("roster")
+	oldFormat, _ := d.GetChange("roster_format")
+	oldEntries, err := parseAccessGroupRoster(oldRoster.(string), oldFormat.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dropped := []accessGroupRosterEntry{}
+	for _, e := range oldEntries {
+		if !managed[e.AccessGroupID] {
+			dropped = append(dropped, e)
+		}
+	}
+	if len(dropped) > 0 {
+		err = removeAccessGroupRosterMembers(meta, userDetails.UserAccount, dropped)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = resourceIBMIAMAccessGroupMembershipSyncReconcile(d, meta, userDetails.UserAccount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMAccessGroupMembershipSyncRead(context, d, meta)
+}
+
+// Deleting removes the members of the roster from the access groups, invited users stay in the account
+func resourceIBMIAMAccessGroupMembershipSyncDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries, _, err := accessGroupMembershipSyncRoster(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = removeAccessGroupRosterMembers(meta, userDetails.UserAccount, entries)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Plan an update when the access groups drifted from the roster, so that the next apply reconciles them
+func resourceIBMIAMAccessGroupMembershipSyncCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if drift, ok := diff.GetOk("drift"); ok && len(drift.([]interface{})) > 0 {
+		return diff.SetNewComputed("drift")
+	}
+	return nil
+}
+
+// resourceIBMIAMAccessGroupMembershipSyncReconcile invites the missing users and then adds and removes members so that
+// the access groups match the roster
+func resourceIBMIAMAccessGroupMembershipSyncReconcile(d *schema.ResourceData, meta interface{}, accountID string) error {
+	entries, groups, err := accessGroupMembershipSyncRoster(d)
+	if err != nil {
+		return err
+	}
+
+	members, unresolved, err := resolveAccessGroupRoster(meta, accountID, entries)
+	if err != nil {
+		return err
+	}
+
+	invited := []string{}
+	if len(unresolved) > 0 {
+		if !d.Get("invite_missing_users").(bool) {
+			return fmt.Errorf("[ERROR] Users %s are not found under account %s, set invite_missing_users to invite them", strings.Join(accessGroupRosterMemberNames(unresolved), ", "), accountID)
+		}
+		invited, err = inviteAccessGroupRosterUsers(meta, accountID, unresolved)
+		if err != nil {
+			return err
+		}
+		members, unresolved, err = resolveAccessGroupRoster(meta, accountID, entries)
+		if err != nil {
+			return err
+		}
+		if len(unresolved) > 0 {
+			return fmt.Errorf("[ERROR] Users %s were invited but are not found under account %s", strings.Join(accessGroupRosterMemberNames(unresolved), ", "), accountID)
+		}
+	}
+
+	changes, err := accessGroupMembershipSyncChanges(meta, groups, members, nil, d.Get("exclusive").(bool))
+	if err != nil {
+		return err
+	}
+	err = applyAccessGroupSyncChanges(meta, changes)
+	if err != nil {
+		return err
+	}
+
+	d.Set("invited_users", invited)
+	return nil
+}
+
+// accessGroupMembershipSyncRoster parses the roster and returns the access groups to reconcile
+func accessGroupMembershipSyncRoster(d *schema.ResourceData) ([]accessGroupRosterEntry, []string, error) {
+	entries, err := parseAccessGroupRoster(d.Get("roster").(string), d.Get("roster_format").(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	rosterGroups := accessGroupRosterGroups(entries)
+
+	v, ok := d.GetOk("access_group_ids")
+	if !ok || v.(*schema.Set).Len() == 0 {
+		return entries, rosterGroups, nil
+	}
+	groups := flex.ExpandStringList(v.(*schema.Set).List())
+	managed := map[string]bool{}
+	for _, g := range groups {
+		managed[g] = true
+	}
+	for _, g := range rosterGroups {
+		if !managed[g] {
+			return nil, nil, fmt.Errorf("[ERROR] Access group %s of the roster is not in access_group_ids", g)
+		}
+	}
+	sort.Strings(groups)
+	return entries, groups, nil
+}
+
+// resolveAccessGroupRoster resolves the members of a roster to their IAM IDs and returns the users that are not in
+// the account
+func resolveAccessGroupRoster(meta interface{}, accountID string, entries []accessGroupRosterEntry) ([]accessGroupRosterMember, []accessGroupRosterEntry, error) {
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := userManagement.UserInvite().ListUsers(accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	userIAMIDs := map[string]string{}
+	for _, user := range users {
+		if user.IamID != "" {
+			userIAMIDs[strings.ToLower(user.Email)] = user.IamID
+		}
+	}
+
+	cache := map[string]string{}
+	members := []accessGroupRosterMember{}
+	unresolved := []accessGroupRosterEntry{}
+	for _, e := range entries {
+		var iamID string
+		switch e.Type {
+		case rosterMemberTypeUser:
+			iamID = userIAMIDs[strings.ToLower(e.Member)]
+			if iamID == "" {
+				unresolved = append(unresolved, e)
+				continue
+			}
+		case rosterMemberTypeService:
+			if strings.HasPrefix(e.Member, "iam-ServiceId-") {
+				iamID = e.Member
+			} else if cached, ok := cache[e.Member]; ok {
+				iamID = cached
+			} else {
+				serviceID, err := getServiceID(e.Member, meta)
+				if err != nil {
+					return nil, nil, err
+				}
+				iamID = *serviceID.IamID
+			}
+		case rosterMemberTypeProfile:
+			if strings.HasPrefix(e.Member, "iam-Profile-") {
+				iamID = e.Member
+			} else if cached, ok := cache[e.Member]; ok {
+				iamID = cached
+			} else {
+				profileID, err := getProfileID(e.Member, meta)
+				if err != nil {
+					return nil, nil, err
+				}
+				iamID = *profileID.IamID
+			}
+		}
+		cache[e.Member] = iamID
+		members = append(members, accessGroupRosterMember{accessGroupRosterEntry: e, IamID: iamID})
+	}
+	return members, unresolved, nil
+}
+
+// inviteAccessGroupRosterUsers invites the users that are not in the account, users with the same access groups are
+// invited together and added to their access groups by the invitation
+func inviteAccessGroupRosterUsers(meta interface{}, accountID string, entries []accessGroupRosterEntry) ([]string, error) {
+	userGroups := map[string][]string{}
+	emails := []string{}
+	for _, e := range entries {
+		email := strings.ToLower(e.Member)
+		if _, ok := userGroups[email]; !ok {
+			emails = append(emails, email)
+		}
+		userGroups[email] = append(userGroups[email], e.AccessGroupID)
+	}
+	sort.Strings(emails)
+
+	batches := map[string][]string{}
+	keys := []string{}
+	for _, email := range emails {
+		groups := userGroups[email]
+		sort.Strings(groups)
+		key := strings.Join(groups, ",")
+		if _, ok := batches[key]; !ok {
+			keys = append(keys, key)
+		}
+		batches[key] = append(batches[key], email)
+	}
+
+	for _, key := range keys {
+		log.Printf("[INFO] Inviting users %v to access groups %s", batches[key], key)
+		err := iampolicy.InviteUsersToAccessGroups(meta, accountID, batches[key], strings.Split(key, ","))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return emails, nil
+}
+
+// accessGroupMembershipSyncChanges compares the static members of the access groups with the roster
+func accessGroupMembershipSyncChanges(meta interface{}, groups []string, members []accessGroupRosterMember, unresolved []accessGroupRosterEntry, exclusive bool) ([]accessGroupSyncChange, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+
+	desired := map[string][]accessGroupRosterMember{}
+	for _, m := range members {
+		desired[m.AccessGroupID] = append(desired[m.AccessGroupID], m)
+	}
+
+	changes := []accessGroupSyncChange{}
+	for _, grpID := range groups {
+		current, detailResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID, "static")
+		if err != nil {
+			if detailResponse != nil && detailResponse.StatusCode == 404 {
+				log.Printf("[WARN] Access group %s not found, it is not reconciled", grpID)
+				continue
+			}
+			return nil, err
+		}
+		currentIAMIDs := map[string]bool{}
+		for _, m := range current {
+			if m.IamID != nil {
+				currentIAMIDs[*m.IamID] = true
+			}
+		}
+		desiredIAMIDs := map[string]bool{}
+		for _, m := range desired[grpID] {
+			desiredIAMIDs[m.IamID] = true
+			if !currentIAMIDs[m.IamID] {
+				changes = append(changes, accessGroupSyncChange{AccessGroupID: grpID, Member: m.Member, IamID: m.IamID, MemberType: m.Type, Action: accessGroupSyncActionAdd})
+			}
+		}
+		if exclusive {
+			for _, m := range current {
+				if m.IamID != nil && !desiredIAMIDs[*m.IamID] {
+					changes = append(changes, accessGroupSyncChange{AccessGroupID: grpID, Member: *m.IamID, IamID: *m.IamID, MemberType: flex.Stringify(m.Type), Action: accessGroupSyncActionRemove})
+				}
+			}
+		}
+	}
+	for _, e := range unresolved {
+		changes = append(changes, accessGroupSyncChange{AccessGroupID: e.AccessGroupID, Member: e.Member, MemberType: e.Type, Action: accessGroupSyncActionInvite})
+	}
+	return changes, nil
+}
+
+// applyAccessGroupSyncChanges adds and removes the members of each access group in batches
+func applyAccessGroupSyncChanges(meta interface{}, changes []accessGroupSyncChange) error {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+
+	groups := []string{}
+	adds := map[string][]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{}
+	removes := map[string][]string{}
+	for _, c := range changes {
+		if _, ok := adds[c.AccessGroupID]; !ok {
+			if _, ok := removes[c.AccessGroupID]; !ok {
+				groups = append(groups, c.AccessGroupID)
+			}
+		}
+		switch c.Action {
+		case accessGroupSyncActionAdd:
+			membersItem, err := iamAccessGroupsClient.NewAddGroupMembersRequestMembersItem(c.IamID, c.MemberType)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error in preparing membership data for %s: %s", c.Member, err)
+			}
+			adds[c.AccessGroupID] = append(adds[c.AccessGroupID], *membersItem)
+		case accessGroupSyncActionRemove:
+			removes[c.AccessGroupID] = append(removes[c.AccessGroupID], c.IamID)
+		}
+	}
+
+	for _, grpID := range groups {
+		items := adds[grpID]
+		for start := 0; start < len(items); start += accessGroupMembersBatchSize {
+			end := start + accessGroupMembersBatchSize
+			if end > len(items) {
+				end = len(items)
+			}
+			addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+			addMembersToAccessGroupOptions.SetMembers(items[start:end])
+			membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+			if err != nil || membership == nil {
+				return fmt.Errorf("[ERROR] Error adding members to group(%s): %s. API response: %s", grpID, err, detailResponse)
+			}
+		}
+		if len(removes[grpID]) > 0 {
+			log.Printf("[INFO] Removing members %v that are not in the roster from access group %s", removes[grpID], grpID)
+			if err := removeAccessGroupMembers(iamAccessGroupsClient, grpID, removes[grpID]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeAccessGroupRosterMembers removes the members of a roster that are still in their access groups
+func removeAccessGroupRosterMembers(meta interface{}, accountID string, entries []accessGroupRosterEntry) error {
+	members, _, err := resolveAccessGroupRoster(meta, accountID, entries)
+	if err != nil {
+		return err
+	}
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+
+	removes := map[string][]string{}
+	for _, m := range members {
+		removes[m.AccessGroupID] = append(removes[m.AccessGroupID], m.IamID)
+	}
+	for _, grpID := range accessGroupRosterGroups(entries) {
+		if len(removes[grpID]) == 0 {
+			continue
+		}
+		current, detailResponse, err := listAccessGroupMembers(iamAccessGroupsClient, grpID, "static")
+		if err != nil {
+			if detailResponse != nil && detailResponse.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+		currentIAMIDs := map[string]bool{}
+		for _, m := range current {
+			if m.IamID != nil {
+				currentIAMIDs[*m.IamID] = true
+			}
+		}
+		iamIDs := []string{}
+		for _, iamID := range removes[grpID] {
+			if currentIAMIDs[iamID] {
+				iamIDs = append(iamIDs, iamID)
+			}
+		}
+		if err := removeAccessGroupMembers(iamAccessGroupsClient, grpID, iamIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func accessGroupRosterMemberNames(entries []accessGroupRosterEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Member)
+	}
+	return names
+}
+
+func flattenAccessGroupRosterMembers(members []accessGroupRosterMember) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		result = append(result, map[string]interface{}{
+			"access_group_id": m.AccessGroupID,
+			"member":          m.Member,
+			"type":            m.Type,
+			"iam_id":          m.IamID,
+		})
+	}
+	return result
+}
+
+func flattenAccessGroupSyncChanges(changes []accessGroupSyncChange) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, map[string]interface{}{
+			"access_group_id": c.AccessGroupID,
+			"member":          c.Member,
+			"iam_id":          c.IamID,
+			"action":          c.Action,
+		})
+	}
+	return result
+}
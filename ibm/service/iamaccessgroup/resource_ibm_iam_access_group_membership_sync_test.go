@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamaccessgroup_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccessGroupMembershipSync_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	name1 := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname1 := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMembershipSyncCSV(name, name1, sname, sname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "managed_access_group_ids.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "members.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "drift.#", "0"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "invited_users.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupMembershipSyncJSON(name, name1, sname, sname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "roster_format", "json"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "managed_access_group_ids.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "members.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_membership_sync.sync", "drift.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessGroupMembershipSyncCSV(name, name1, sname, sname1 string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group" "accgroup1" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID1" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_membership_sync" "sync" {
+		roster = <<-EOT
+			access_group_id,member,type
+			${ibm_iam_access_group.accgroup.id},${ibm_iam_service_id.serviceID.id},service
+			${ibm_iam_access_group.accgroup.id},${ibm_iam_service_id.serviceID1.iam_id},service
+			${ibm_iam_access_group.accgroup1.id},${ibm_iam_service_id.serviceID.id},service
+		EOT
+	}`, name, name1, sname, sname1)
+}
+
+func testAccCheckIBMIAMAccessGroupMembershipSyncJSON(name, name1, sname, sname1 string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group" "accgroup1" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID1" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_membership_sync" "sync" {
+		roster_format    = "json"
+		access_group_ids = [ibm_iam_access_group.accgroup.id, ibm_iam_access_group.accgroup1.id]
+		exclusive        = true
+		roster = jsonencode([
+			{
+				access_group_id = ibm_iam_access_group.accgroup.id
+				member          = ibm_iam_service_id.serviceID1.id
+				type            = "service"
+			},
+		])
+	}`, name, name1, sname, sname1)
+}
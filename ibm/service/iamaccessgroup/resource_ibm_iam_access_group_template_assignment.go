@@ -27,7 +27,7 @@ const (
 )
 
 func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddTemplateAssignmentDriftFields(&schema.Resource{
 		CreateContext: resourceIBMIAMAccessGroupTemplateAssignmentCreate,
 		ReadContext:   resourceIBMIAMAccessGroupTemplateAssignmentRead,
 		UpdateContext: resourceIBMIAMAccessGroupTemplateAssignmentUpdate,
@@ -116,7 +116,7 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMIAMAccessGroupTemplateAssignmentValidator() *validate.ResourceValidator {
@@ -265,6 +265,9 @@ func resourceIBMIAMAccessGroupTemplateAssignmentRead(context context.Context, d
 	if err = d.Set("etag", response.Headers.Get("Etag")); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting etag: %s", err))
 	}
+	if err = flex.SetTemplateAssignmentDrift(d, accessGroupTemplateAssignmentDrift(context, iamAccessGroupsClient, templateAssignmentVerboseResponse)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -303,12 +306,31 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 		hasChange = true
 	}
 
+	// Drifted accounts are remediated by running the assignment again with its current version
+	if flex.TemplateAssignmentNeedsRemediation(d) {
+		updateAssignmentOptions.SetTemplateVersion(d.Get("template_version").(string))
+		hasChange = true
+	}
+
 	if hasChange {
 		_, response, err := iamAccessGroupsClient.UpdateAssignmentWithContext(context, updateAssignmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
 		}
+		if d.Get("auto_remediate").(bool) {
+			// The outcome is reported for each target account rather than as a single failure
+			err = flex.WaitForTemplateAssignmentSettled(context, d.Timeout(schema.TimeoutUpdate), func() (string, error) {
+				return accessGroupTemplateAssignmentStatus(context, iamAccessGroupsClient, d.Id())
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error assigning %s", err))
+			}
+			if diags := resourceIBMIAMAccessGroupTemplateAssignmentRead(context, d, meta); diags.HasError() {
+				return diags
+			}
+			return flex.TemplateAssignmentDriftDiagnostics(d)
+		}
 		waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
 	}
 
@@ -40,6 +40,7 @@ func TestAccIBMIAMAccessGroupTemplateAssignmentBasic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIBMIAMAccessGroupTemplateAssignmentExists("ibm_iam_access_group_template_assignment.assignment", conf),
 					resource.TestCheckResourceAttr("ibm_iam_access_group_template_assignment.assignment", "target", target),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_template_assignment.assignment", "drifted_accounts.#", "0"),
 				),
 			},
 		},
@@ -21,7 +21,7 @@ import (
 )
 
 func ResourceIBMAccountSettingsTemplateAssignment() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddTemplateAssignmentDriftFields(&schema.Resource{
 		CreateContext: resourceIBMAccountSettingsTemplateAssignmentCreate,
 		ReadContext:   resourceIBMAccountSettingsTemplateAssignmentRead,
 		UpdateContext: resourceIBMAccountSettingsTemplateAssignmentUpdate,
@@ -271,7 +271,7 @@ func ResourceIBMAccountSettingsTemplateAssignment() *schema.Resource {
 				Description: "Entity tag for this assignment record.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMAccountSettingsTemplateAssignmentValidator() *validate.ResourceValidator {
@@ -418,6 +418,9 @@ func resourceIBMAccountSettingsTemplateAssignmentRead(context context.Context, d
 	if err = d.Set("entity_tag", templateAssignmentResponse.EntityTag); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting entity_tag: %s", err))
 	}
+	if err = flex.SetTemplateAssignmentDrift(d, accountSettingsAssignmentDrift(context, iamIdentityClient, templateAssignmentResponse)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -439,13 +442,33 @@ func resourceIBMAccountSettingsTemplateAssignmentUpdate(context context.Context,
 		hasChange = true
 	}
 
-	if hasChange || d.Get("status") == "failed" { // allow the same version to retry failed assignments
+	// Drifted accounts are remediated by running the assignment again with its current version
+	remediate := flex.TemplateAssignmentNeedsRemediation(d)
+
+	if hasChange || d.Get("status") == "failed" || remediate { // allow the same version to retry failed assignments
+		if !hasChange {
+			updateAccountSettingsAssignmentOptions.SetTemplateVersion(int64(d.Get("template_version").(int)))
+		}
 		_, response, err := iamIdentityClient.UpdateAccountSettingsAssignmentWithContext(context, updateAccountSettingsAssignmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateAccountSettingsAssignmentWithContext failed %s\n%s", err, response))
 		}
 
+		if d.Get("auto_remediate").(bool) {
+			// The outcome is reported for each target account rather than as a single failure
+			err = flex.WaitForTemplateAssignmentSettled(context, d.Timeout(schema.TimeoutUpdate), func() (string, error) {
+				return accountSettingsAssignmentStatus(context, iamIdentityClient, d.Id())
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error assigning %s", err))
+			}
+			if diags := resourceIBMAccountSettingsTemplateAssignmentRead(context, d, meta); diags.HasError() {
+				return diags
+			}
+			return flex.TemplateAssignmentDriftDiagnostics(d)
+		}
+
 		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccountSettingsTemplateAssigned)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error assigning %s", err))
@@ -48,6 +48,7 @@ func TestAccIBMAccountSettingsTemplateAssignmentBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("ibm_iam_account_settings_template_assignment.account_settings_template_assignment_instance", "last_modified_at"),
 					resource.TestCheckResourceAttrSet("ibm_iam_account_settings_template_assignment.account_settings_template_assignment_instance", "last_modified_by_id"),
 					resource.TestCheckResourceAttrSet("ibm_iam_account_settings_template_assignment.account_settings_template_assignment_instance", "entity_tag"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template_assignment.account_settings_template_assignment_instance", "drifted_accounts.#", "0"),
 				),
 			},
 			{
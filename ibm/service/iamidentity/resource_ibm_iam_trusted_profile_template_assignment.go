@@ -27,7 +27,7 @@ const (
 )
 
 func ResourceIBMTrustedProfileTemplateAssignment() *schema.Resource {
-	return &schema.Resource{
+	return flex.AddTemplateAssignmentDriftFields(&schema.Resource{
 		CreateContext: resourceIBMTrustedProfileTemplateAssignmentCreate,
 		ReadContext:   resourceIBMTrustedProfileTemplateAssignmentRead,
 		UpdateContext: resourceIBMTrustedProfileTemplateAssignmentUpdate,
@@ -354,7 +354,7 @@ func ResourceIBMTrustedProfileTemplateAssignment() *schema.Resource {
 				Description: "Entity tag for this assignment record.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMTrustedProfileTemplateAssignmentValidator() *validate.ResourceValidator {
@@ -495,6 +495,9 @@ func resourceIBMTrustedProfileTemplateAssignmentRead(context context.Context, d
 	if err = d.Set("entity_tag", templateAssignmentResponse.EntityTag); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting entity_tag: %s", err))
 	}
+	if err = flex.SetTemplateAssignmentDrift(d, trustedProfileAssignmentDrift(context, iamIdentityClient, templateAssignmentResponse)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -516,13 +519,33 @@ func resourceIBMTrustedProfileTemplateAssignmentUpdate(context context.Context,
 		hasChange = true
 	}
 
-	if hasChange || d.Get("status") == "failed" { // allow the same version to retry failed assignments
+	// Drifted accounts are remediated by running the assignment again with its current version
+	remediate := flex.TemplateAssignmentNeedsRemediation(d)
+
+	if hasChange || d.Get("status") == "failed" || remediate { // allow the same version to retry failed assignments
+		if !hasChange {
+			updateTrustedProfileAssignmentOptions.SetTemplateVersion(int64(d.Get("template_version").(int)))
+		}
 		_, response, err := iamIdentityClient.UpdateTrustedProfileAssignmentWithContext(context, updateTrustedProfileAssignmentOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateTrustedProfileAssignmentWithContext failed %s\n%s", err, response))
 		}
 
+		if d.Get("auto_remediate").(bool) {
+			// The outcome is reported for each target account rather than as a single failure
+			err = flex.WaitForTemplateAssignmentSettled(context, d.Timeout(schema.TimeoutUpdate), func() (string, error) {
+				return trustedProfileAssignmentStatus(context, iamIdentityClient, d.Id())
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error assigning %s", err))
+			}
+			if diags := resourceIBMTrustedProfileTemplateAssignmentRead(context, d, meta); diags.HasError() {
+				return diags
+			}
+			return flex.TemplateAssignmentDriftDiagnostics(d)
+		}
+
 		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isTrustedProfileTemplateAssigned)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error assigning %s", err))
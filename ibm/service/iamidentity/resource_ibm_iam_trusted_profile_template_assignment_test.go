@@ -47,6 +47,7 @@ func TestAccIBMTrustedProfileTemplateAssignmentBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_template_assignment.trusted_profile_template_assignment_instance", "last_modified_at"),
 					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_template_assignment.trusted_profile_template_assignment_instance", "last_modified_by_id"),
 					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_template_assignment.trusted_profile_template_assignment_instance", "entity_tag"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_assignment.trusted_profile_template_assignment_instance", "drifted_accounts.#", "0"),
 				),
 			},
 			{
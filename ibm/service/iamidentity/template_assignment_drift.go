@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func templateAssignmentInProgress(status *string) bool {
+	return status != nil && (*status == "accepted" || *status == "in_progress")
+}
+
+// addTemplateAssignmentDetailDrift reports a failed IAM resource of an assignment in a target account
+func addTemplateAssignmentDetailDrift(report *flex.TemplateAssignmentDriftReport, accountID, kind string, detail *iamidentityv1.TemplateAssignmentResponseResourceDetail) bool {
+	if detail == nil {
+		return false
+	}
+	message := ""
+	if detail.ErrorMessage != nil && detail.ErrorMessage.Message != nil {
+		message = *detail.ErrorMessage.Message
+	}
+	if (detail.Status != nil && *detail.Status == "failed") || message != "" {
+		id := ""
+		if detail.ID != nil {
+			id = " " + *detail.ID
+		}
+		report.Add(accountID, flex.TemplateAssignmentFailed, fmt.Sprintf("%s%s failed: %s", kind, id, message))
+		return true
+	}
+	return false
+}
+
+// trustedProfileAssignmentDrift reports the target accounts whose trusted profile failed, was deleted or no longer
+// belongs to the assignment
+func trustedProfileAssignmentDrift(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, assignment *iamidentityv1.TemplateAssignmentResponse) []flex.TemplateAssignmentDrift {
+	report := flex.NewTemplateAssignmentDriftReport()
+	if templateAssignmentInProgress(assignment.Status) {
+		return report.List()
+	}
+	for _, resource := range assignment.Resources {
+		if resource.Target == nil {
+			continue
+		}
+		accountID := *resource.Target
+		report.Add(accountID, flex.TemplateAssignmentInSync, "")
+		for i := range resource.PolicyTemplateRefs {
+			addTemplateAssignmentDetailDrift(report, accountID, "policy template", &resource.PolicyTemplateRefs[i])
+		}
+		if addTemplateAssignmentDetailDrift(report, accountID, "trusted profile", resource.Profile) {
+			continue
+		}
+		if resource.Profile == nil || resource.Profile.ResourceCreated == nil || resource.Profile.ResourceCreated.ID == nil {
+			continue
+		}
+
+		profileID := *resource.Profile.ResourceCreated.ID
+		getProfileOptions := &iamidentityv1.GetProfileOptions{}
+		getProfileOptions.SetProfileID(profileID)
+		profile, response, err := iamIdentityClient.GetProfileWithContext(context, getProfileOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				report.Add(accountID, flex.TemplateAssignmentMissing, fmt.Sprintf("trusted profile %s was deleted", profileID))
+			} else {
+				report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("trusted profile %s could not be read: %s", profileID, err))
+			}
+			continue
+		}
+		if profile.AssignmentID == nil || *profile.AssignmentID != *assignment.ID {
+			report.Add(accountID, flex.TemplateAssignmentModified, fmt.Sprintf("trusted profile %s is no longer managed by the assignment", profileID))
+		}
+	}
+	return report.List()
+}
+
+// accountSettingsAssignmentDrift reports the target accounts whose settings failed or differ from the template version
+func accountSettingsAssignmentDrift(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, assignment *iamidentityv1.TemplateAssignmentResponse) []flex.TemplateAssignmentDrift {
+	report := flex.NewTemplateAssignmentDriftReport()
+	if templateAssignmentInProgress(assignment.Status) {
+		return report.List()
+	}
+
+	var template *iamidentityv1.AccountSettingsComponent
+	var templateErr error
+	if assignment.TemplateID != nil && assignment.TemplateVersion != nil {
+		getOptions := &iamidentityv1.GetAccountSettingsTemplateVersionOptions{}
+		getOptions.SetTemplateID(*assignment.TemplateID)
+		getOptions.SetVersion(strconv.FormatInt(*assignment.TemplateVersion, 10))
+		templateVersion, response, err := iamIdentityClient.GetAccountSettingsTemplateVersionWithContext(context, getOptions)
+		if err != nil {
+			templateErr = fmt.Errorf("%s\n%s", err, response)
+		} else {
+			template = templateVersion.AccountSettings
+		}
+	}
+
+	for _, resource := range assignment.Resources {
+		if resource.Target == nil {
+			continue
+		}
+		accountID := *resource.Target
+		report.Add(accountID, flex.TemplateAssignmentInSync, "")
+		if addTemplateAssignmentDetailDrift(report, accountID, "account settings", resource.AccountSettings) {
+			continue
+		}
+		if templateErr != nil {
+			report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("the account settings template could not be read: %s", templateErr))
+			continue
+		}
+		if template == nil {
+			continue
+		}
+
+		getAccountSettingsOptions := &iamidentityv1.GetAccountSettingsOptions{}
+		getAccountSettingsOptions.SetAccountID(accountID)
+		settings, response, err := iamIdentityClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
+		if err != nil {
+			report.Add(accountID, flex.TemplateAssignmentUnknown, fmt.Sprintf("the account settings could not be read: %s\n%s", err, response))
+			continue
+		}
+		for _, reason := range accountSettingsDriftReasons(template, settings) {
+			report.Add(accountID, flex.TemplateAssignmentModified, reason)
+		}
+	}
+	return report.List()
+}
+
+// accountSettingsDriftReasons compares the settings of an account with those the template sets
+func accountSettingsDriftReasons(template *iamidentityv1.AccountSettingsComponent, settings *iamidentityv1.AccountSettingsResponse) []string {
+	fields := []struct {
+		name     string
+		template *string
+		account  *string
+	}{
+		{"restrict_create_service_id", template.RestrictCreateServiceID, settings.RestrictCreateServiceID},
+		{"restrict_create_platform_apikey", template.RestrictCreatePlatformApikey, settings.RestrictCreatePlatformApikey},
+		{"allowed_ip_addresses", template.AllowedIPAddresses, settings.AllowedIPAddresses},
+		{"mfa", template.Mfa, settings.Mfa},
+		{"session_expiration_in_seconds", template.SessionExpirationInSeconds, settings.SessionExpirationInSeconds},
+		{"session_invalidation_in_seconds", template.SessionInvalidationInSeconds, settings.SessionInvalidationInSeconds},
+		{"max_sessions_per_identity", template.MaxSessionsPerIdentity, settings.MaxSessionsPerIdentity},
+		{"system_access_token_expiration_in_seconds", template.SystemAccessTokenExpirationInSeconds, settings.SystemAccessTokenExpirationInSeconds},
+		{"system_refresh_token_expiration_in_seconds", template.SystemRefreshTokenExpirationInSeconds, settings.SystemRefreshTokenExpirationInSeconds},
+	}
+	reasons := []string{}
+	for _, field := range fields {
+		if field.template == nil {
+			continue
+		}
+		account := ""
+		if field.account != nil {
+			account = *field.account
+		}
+		if account != *field.template {
+			reasons = append(reasons, fmt.Sprintf("%s is %q, the template sets %q", field.name, account, *field.template))
+		}
+	}
+	return reasons
+}
+
+func trustedProfileAssignmentStatus(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, id string) (string, error) {
+	getOptions := &iamidentityv1.GetTrustedProfileAssignmentOptions{}
+	getOptions.SetAssignmentID(id)
+	assignment, response, err := iamIdentityClient.GetTrustedProfileAssignmentWithContext(context, getOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetTrustedProfileAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return *assignment.Status, nil
+}
+
+func accountSettingsAssignmentStatus(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, id string) (string, error) {
+	getOptions := &iamidentityv1.GetAccountSettingsAssignmentOptions{}
+	getOptions.SetAssignmentID(id)
+	assignment, response, err := iamIdentityClient.GetAccountSettingsAssignmentWithContext(context, getOptions)
+	if err != nil {
+		return "", fmt.Errorf("GetAccountSettingsAssignmentWithContext failed %s\n%s", err, response)
+	}
+	return *assignment.Status, nil
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountSettingsDriftReasons(t *testing.T) {
+	template := &iamidentityv1.AccountSettingsComponent{
+		Mfa:                        core.StringPtr("TOTP"),
+		RestrictCreateServiceID:    core.StringPtr("RESTRICTED"),
+		SessionExpirationInSeconds: core.StringPtr("1800"),
+	}
+	settings := &iamidentityv1.AccountSettingsResponse{
+		Mfa:                          core.StringPtr("NONE"),
+		RestrictCreateServiceID:      core.StringPtr("RESTRICTED"),
+		RestrictCreatePlatformApikey: core.StringPtr("NOT_RESTRICTED"),
+	}
+
+	assert.Equal(t, []string{
+		`mfa is "NONE", the template sets "TOTP"`,
+		`session_expiration_in_seconds is "", the template sets "1800"`,
+	}, accountSettingsDriftReasons(template, settings))
+
+	settings.Mfa = core.StringPtr("TOTP")
+	settings.SessionExpirationInSeconds = core.StringPtr("1800")
+	assert.Empty(t, accountSettingsDriftReasons(template, settings))
+}
+
+func TestAddTemplateAssignmentDetailDrift(t *testing.T) {
+	report := flex.NewTemplateAssignmentDriftReport()
+	assert.False(t, addTemplateAssignmentDetailDrift(report, "account-a", "trusted profile", nil))
+	assert.False(t, addTemplateAssignmentDetailDrift(report, "account-a", "trusted profile", &iamidentityv1.TemplateAssignmentResponseResourceDetail{
+		Status: core.StringPtr("succeeded"),
+	}))
+	assert.True(t, addTemplateAssignmentDetailDrift(report, "account-b", "policy template", &iamidentityv1.TemplateAssignmentResponseResourceDetail{
+		ID:           core.StringPtr("policyTemplate-1"),
+		Status:       core.StringPtr("failed"),
+		ErrorMessage: &iamidentityv1.TemplateAssignmentResourceError{Message: core.StringPtr("role not found")},
+	}))
+
+	assert.Equal(t, []flex.TemplateAssignmentDrift{
+		{AccountID: "account-b", Status: flex.TemplateAssignmentFailed, Reasons: []string{"policy template policyTemplate-1 failed: role not found"}},
+	}, report.List())
+}
@@ -0,0 +1,487 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var iamAccessCheckSubjects = []string{"ibm_id", "iam_id", "iam_service_id", "profile_id"}
+
+// Data source to check whether a subject is allowed to perform an action on a resource
+func DataSourceIBMIAMAccessCheck() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMAccessCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"ibm_id": {
+				Description:  "The IBMid or email address of the user",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: iamAccessCheckSubjects,
+			},
+			"iam_id": {
+				Description:  "The IAM ID of the user, service ID or trusted profile",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: iamAccessCheckSubjects,
+			},
+			"iam_service_id": {
+				Description:  "The UUID of the service ID",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: iamAccessCheckSubjects,
+			},
+			"profile_id": {
+				Description:  "The UUID of the trusted profile",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: iamAccessCheckSubjects,
+			},
+			"action": {
+				Description: "The action to check, for example cloud-object-storage.object.get",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_crn": {
+				Description:  "The CRN of the target resource",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"resource_crn", "resource_attributes"},
+			},
+			"resource_attributes": {
+				Description:  "Attributes of the target resource, they are added to or override the attributes taken from resource_crn",
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"resource_crn", "resource_attributes"},
+			},
+			"resource_tags": {
+				Description: "The access tags of the target resource in key:value format, used to evaluate policies scoped to access tags. Looked up from resource_crn when not set",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"request_time": {
+				Description:  "The time of the request used to evaluate time-based conditions, in RFC 3339 format. Defaults to the current time",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_access_check", "request_time"),
+			},
+			"allowed": {
+				Description: "Whether any policy allows the action on the resource",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"decision": {
+				Description: "The result of the check, allow, deny or indeterminate when no policy allows the action but some policies could not be evaluated",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subject_iam_id": {
+				Description: "The IAM ID of the subject",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"access_group_ids": {
+				Description: "The access groups the subject is a member of",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"evaluated_resource_attributes": {
+				Description: "The resource attributes the policies were evaluated against",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"evaluated_resource_tags": {
+				Description: "The access tags the policies were evaluated against",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"matching_policy_ids": {
+				Description: "The IDs of the policies that allow the action",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"matching_policies": {
+				Description: "The policies that allow the action",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the policy",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"access_group_id": {
+							Description: "The access group the policy is assigned to, empty for policies assigned to the subject",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"roles": {
+							Description: "The roles of the policy that include the action",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"unevaluated_policy_ids": {
+				Description: "The IDs of the policies that include the action but whose resource or rule conditions could not be evaluated",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"evaluated_policy_count": {
+				Description: "The number of policies that were evaluated",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func DataSourceIBMIAMAccessCheckValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "request_time",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`,
+		})
+
+	iBMIAMAccessCheckValidator := validate.ResourceValidator{ResourceName: "ibm_iam_access_check", Schema: validateSchema}
+	return &iBMIAMAccessCheckValidator
+}
+
+// iamAccessCheckPolicy is a policy that applies to the subject, directly or through an access group
+type iamAccessCheckPolicy struct {
+	policy        iampolicymanagementv1.V2PolicyTemplateMetaData
+	accessGroupID string
+}
+
+func dataSourceIBMIAMAccessCheckRead(d *schema.ResourceData, meta interface{}) error {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	accountID := userDetails.UserAccount
+
+	iamID, err := dataSourceIBMIAMAccessCheckSubject(d, meta, accountID)
+	if err != nil {
+		return err
+	}
+
+	requestTime := time.Now()
+	if v, ok := d.GetOk("request_time"); ok {
+		requestTime, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Invalid request_time %s: %s", v, err)
+		}
+	}
+
+	attributes, err := dataSourceIBMIAMAccessCheckResourceAttributes(d, meta, accountID)
+	if err != nil {
+		return err
+	}
+	serviceName := attributes["serviceName"]
+	if serviceName == "" {
+		return fmt.Errorf("[ERROR] The serviceName of the resource is required, set resource_crn or the serviceName resource attribute")
+	}
+
+	tags := dataSourceIBMIAMAccessCheckResourceTags(d, meta)
+
+	accessGroupIDs, err := dataSourceIBMIAMAccessCheckAccessGroups(meta, accountID, iamID)
+	if err != nil {
+		return err
+	}
+
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	policies := []iamAccessCheckPolicy{}
+	listPoliciesOptions := &iampolicymanagementv1.ListV2PoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Type:      core.StringPtr("access"),
+	}
+	policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+	if err != nil || policyList == nil {
+		return fmt.Errorf("[ERROR] Error listing policies of %s: %s, %s", iamID, err, resp)
+	}
+	for _, policy := range policyList.Policies {
+		policies = append(policies, iamAccessCheckPolicy{policy: policy})
+	}
+	for _, accessGroupID := range accessGroupIDs {
+		listPoliciesOptions := &iampolicymanagementv1.ListV2PoliciesOptions{
+			AccountID:     core.StringPtr(accountID),
+			AccessGroupID: core.StringPtr(accessGroupID),
+			Type:          core.StringPtr("access"),
+		}
+		policyList, resp, err := iamPolicyManagementClient.ListV2Policies(listPoliciesOptions)
+		if err != nil || policyList == nil {
+			return fmt.Errorf("[ERROR] Error listing policies of access group %s: %s, %s", accessGroupID, err, resp)
+		}
+		for _, policy := range policyList.Policies {
+			policies = append(policies, iamAccessCheckPolicy{policy: policy, accessGroupID: accessGroupID})
+		}
+	}
+
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   core.StringPtr(accountID),
+		ServiceName: core.StringPtr(serviceName),
+	}
+	roleList, resp, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil || roleList == nil {
+		return fmt.Errorf("[ERROR] Error listing roles of service %s: %s, %s", serviceName, err, resp)
+	}
+	roleActions := iamRoleActions(*roleList)
+
+	action := d.Get("action").(string)
+	matchingPolicyIDs := []string{}
+	matchingPolicies := []map[string]interface{}{}
+	unevaluatedPolicyIDs := []string{}
+	for _, p := range policies {
+		policy := p.policy
+		if policy.ID == nil || (policy.State != nil && *policy.State != iampolicymanagementv1.V2PolicyTemplateMetaDataStateActiveConst) {
+			continue
+		}
+
+		roles := []string{}
+		for _, roleID := range iamPolicyRoleIDs(policy) {
+			role, ok := roleActions[roleID]
+			if !ok {
+				continue
+			}
+			for _, a := range role.actions {
+				if a == action {
+					roles = append(roles, role.displayName)
+					break
+				}
+			}
+		}
+		if len(roles) == 0 {
+			continue
+		}
+
+		matches, err := iamPolicyResourceMatches(policy.Resource, attributes, tags)
+		if err != nil {
+			log.Printf("[WARN] Policy %s cannot be evaluated: %s", *policy.ID, err)
+			unevaluatedPolicyIDs = append(unevaluatedPolicyIDs, *policy.ID)
+			continue
+		}
+		if !matches {
+			continue
+		}
+
+		matches, err = iamPolicyRuleMatches(policy.Rule, attributes, requestTime)
+		if err != nil {
+			log.Printf("[WARN] Policy %s rule conditions cannot be evaluated: %s", *policy.ID, err)
+			unevaluatedPolicyIDs = append(unevaluatedPolicyIDs, *policy.ID)
+			continue
+		}
+		if !matches {
+			continue
+		}
+
+		matchingPolicyIDs = append(matchingPolicyIDs, *policy.ID)
+		matchingPolicies = append(matchingPolicies, map[string]interface{}{
+			"id":              *policy.ID,
+			"access_group_id": p.accessGroupID,
+			"roles":           roles,
+		})
+	}
+
+	allowed := len(matchingPolicyIDs) > 0
+	decision := iamAccessCheckDecision(len(matchingPolicyIDs), len(unevaluatedPolicyIDs))
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", iamID, action, flex.Stringify(d.Get("resource_crn"))))
+	d.Set("subject_iam_id", iamID)
+	d.Set("allowed", allowed)
+	d.Set("decision", decision)
+	d.Set("access_group_ids", accessGroupIDs)
+	d.Set("evaluated_resource_attributes", attributes)
+	d.Set("evaluated_resource_tags", tags)
+	d.Set("matching_policy_ids", matchingPolicyIDs)
+	d.Set("matching_policies", matchingPolicies)
+	d.Set("unevaluated_policy_ids", unevaluatedPolicyIDs)
+	d.Set("evaluated_policy_count", len(policies))
+
+	return nil
+}
+
+// Resolve the IAM ID of the subject
+func dataSourceIBMIAMAccessCheckSubject(d *schema.ResourceData, meta interface{}, accountID string) (string, error) {
+	if v, ok := d.GetOk("iam_id"); ok {
+		return v.(string), nil
+	}
+	if v, ok := d.GetOk("ibm_id"); ok {
+		return flex.GetIBMUniqueId(accountID, v.(string), meta)
+	}
+
+	iamClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", err
+	}
+	if v, ok := d.GetOk("iam_service_id"); ok {
+		serviceIDUUID := v.(string)
+		getServiceIDOptions := iamidentityv1.GetServiceIDOptions{
+			ID: &serviceIDUUID,
+		}
+		serviceID, resp, err := iamClient.GetServiceID(&getServiceIDOptions)
+		if err != nil || serviceID == nil {
+			return "", fmt.Errorf("[ERROR] Error getting service ID %s: %s %s", serviceIDUUID, err, resp)
+		}
+		return *serviceID.IamID, nil
+	}
+
+	profileUUID := d.Get("profile_id").(string)
+	getProfileOptions := iamidentityv1.GetProfileOptions{
+		ProfileID: &profileUUID,
+	}
+	profile, resp, err := iamClient.GetProfile(&getProfileOptions)
+	if err != nil || profile == nil {
+		return "", fmt.Errorf("[ERROR] Error getting profile ID %s: %s %s", profileUUID, err, resp)
+	}
+	return *profile.IamID, nil
+}
+
+// Build the attributes of the target resource from its CRN and the given attributes. The resource group of a
+// service instance is looked up when it is not given, so that policies on the resource group are evaluated too
+func dataSourceIBMIAMAccessCheckResourceAttributes(d *schema.ResourceData, meta interface{}, accountID string) (map[string]string, error) {
+	attributes := map[string]string{}
+	if v, ok := d.GetOk("resource_crn"); ok {
+		crnAttributes, err := iamResourceAttributesFromCRN(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		attributes = crnAttributes
+	}
+	if v, ok := d.GetOk("resource_attributes"); ok {
+		for k, value := range v.(map[string]interface{}) {
+			attributes[k] = value.(string)
+		}
+	}
+	if _, ok := attributes["accountId"]; !ok {
+		attributes["accountId"] = accountID
+	}
+	if _, ok := attributes["serviceType"]; !ok && attributes["serviceName"] != "" {
+		attributes["serviceType"] = "service"
+	}
+
+	if _, ok := attributes["resourceGroupId"]; !ok && attributes["serviceInstance"] != "" {
+		rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return nil, err
+		}
+		instance, resp, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+			ID: core.StringPtr(attributes["serviceInstance"]),
+		})
+		if err != nil || instance == nil || instance.ResourceGroupID == nil {
+			log.Printf("[WARN] Resource group of service instance %s not found, policies on resource groups are not evaluated: %s %s", attributes["serviceInstance"], err, resp)
+		} else {
+			attributes["resourceGroupId"] = *instance.ResourceGroupID
+		}
+	}
+
+	return attributes, nil
+}
+
+// Get the access tags of the target resource, nil when they are not given and cannot be looked up so that policies
+// scoped to access tags are reported as not evaluated
+func dataSourceIBMIAMAccessCheckResourceTags(d *schema.ResourceData, meta interface{}) []string {
+	if v, ok := d.GetOk("resource_tags"); ok {
+		return flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	resourceCRN, ok := d.GetOk("resource_crn")
+	if !ok {
+		log.Printf("[WARN] Access tags of the resource are not known without resource_crn or resource_tags, policies on access tags cannot be evaluated")
+		return nil
+	}
+	accessTags, err := flex.GetGlobalTagsUsingCRN(meta, resourceCRN.(string), "", "access")
+	if err != nil || accessTags == nil {
+		log.Printf("[WARN] Access tags of resource %s not found, policies on access tags cannot be evaluated: %s", resourceCRN, err)
+		return nil
+	}
+	return flex.ExpandStringList(accessTags.List())
+}
+
+// List the access groups the subject is a member of
+func dataSourceIBMIAMAccessCheckAccessGroups(meta interface{}, accountID, iamID string) ([]string, error) {
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return nil, err
+	}
+
+	accessGroupIDs := []string{}
+	var offset int64
+	for {
+		listAccessGroupsOptions := &iamaccessgroupsv2.ListAccessGroupsOptions{
+			AccountID: core.StringPtr(accountID),
+			IamID:     core.StringPtr(iamID),
+			Limit:     core.Int64Ptr(100),
+			Offset:    core.Int64Ptr(offset),
+		}
+		groups, resp, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupsOptions)
+		if err != nil || groups == nil {
+			return nil, fmt.Errorf("[ERROR] Error listing access groups of %s: %s %s", iamID, err, resp)
+		}
+		for _, group := range groups.Groups {
+			if group.ID != nil {
+				accessGroupIDs = append(accessGroupIDs, *group.ID)
+			}
+		}
+		offset += int64(len(groups.Groups))
+		if len(groups.Groups) == 0 || groups.TotalCount == nil || offset >= *groups.TotalCount {
+			break
+		}
+	}
+	sort.Strings(accessGroupIDs)
+	return accessGroupIDs, nil
+}
+
+type iamRole struct {
+	displayName string
+	actions     []string
+}
+
+// Map the role CRNs of a service to their display names and actions
+func iamRoleActions(roleList iampolicymanagementv1.RoleCollection) map[string]iamRole {
+	roles := map[string]iamRole{}
+	for _, r := range roleList.CustomRoles {
+		if r.CRN != nil && r.DisplayName != nil {
+			roles[*r.CRN] = iamRole{displayName: *r.DisplayName, actions: r.Actions}
+		}
+	}
+	for _, r := range append(roleList.ServiceRoles, roleList.SystemRoles...) {
+		if r.CRN != nil && r.DisplayName != nil {
+			roles[*r.CRN] = iamRole{displayName: *r.DisplayName, actions: r.Actions}
+		}
+	}
+	return roles
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccessCheckDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessCheckDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.allowed", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.allowed", "decision", "allow"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.allowed", "matching_policy_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_access_check.allowed", "matching_policy_ids.0", "ibm_iam_service_policy.policy", "id"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_access_check.allowed", "subject_iam_id", "ibm_iam_service_id.serviceID", "iam_id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.outside_window", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.outside_window", "decision", "deny"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.outside_window", "matching_policy_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_iam_access_check.other_service", "allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccessCheckDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_service_policy" "policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles          = ["Reader"]
+			resources {
+				service = "kms"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_date_time}}"
+				operator = "dateTimeGreaterThanOrEquals"
+				value    = ["2022-10-01T12:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_date_time}}"
+				operator = "dateTimeLessThanOrEquals"
+				value    = ["2022-10-31T12:00:00+00:00"]
+			}
+			rule_operator = "and"
+			pattern       = "time-based-conditions:once"
+		}
+
+		data "ibm_iam_access_check" "allowed" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			action         = "kms.secrets.list"
+			resource_attributes = {
+				serviceName = "kms"
+			}
+			request_time = "2022-10-15T12:00:00Z"
+			depends_on   = [ibm_iam_service_policy.policy]
+		}
+
+		data "ibm_iam_access_check" "outside_window" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			action         = "kms.secrets.list"
+			resource_attributes = {
+				serviceName = "kms"
+			}
+			request_time = "2022-11-15T12:00:00Z"
+			depends_on   = [ibm_iam_service_policy.policy]
+		}
+
+		data "ibm_iam_access_check" "other_service" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			action         = "cloud-object-storage.object.get"
+			resource_attributes = {
+				serviceName = "cloud-object-storage"
+			}
+			request_time = "2022-10-15T12:00:00Z"
+			depends_on   = [ibm_iam_service_policy.policy]
+		}
+	`, name)
+}
@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+const (
+	iamConditionCurrentTime     = "{{environment.attributes.current_time}}"
+	iamConditionCurrentDateTime = "{{environment.attributes.current_date_time}}"
+	iamConditionDayOfWeek       = "{{environment.attributes.day_of_week}}"
+	iamConditionResourcePrefix  = "{{resource.attributes."
+)
+
+// iamAccessCheckDecision returns allow when a policy allows the action. Without such a policy the decision is only
+// deny when every policy could be evaluated, a policy that could not be evaluated might still allow the action
+func iamAccessCheckDecision(matching, unevaluated int) string {
+	switch {
+	case matching > 0:
+		return "allow"
+	case unevaluated > 0:
+		return "indeterminate"
+	}
+	return "deny"
+}
+
+// iamResourceAttributesFromCRN maps the segments of a CRN to the resource attributes used by access policies
+func iamResourceAttributesFromCRN(resourceCRN string) (map[string]string, error) {
+	crn, err := flex.Parse(resourceCRN)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid resource CRN %s: %s", resourceCRN, err)
+	}
+
+	attributes := map[string]string{}
+	segments := map[string]string{
+		"serviceName":     crn.ServiceName,
+		"serviceInstance": crn.ServiceInstance,
+		"resourceType":    crn.ResourceType,
+		"resource":        crn.Resource,
+	}
+	if crn.Region != "global" {
+		segments["region"] = crn.Region
+	}
+	if crn.ScopeType == "a" {
+		segments["accountId"] = crn.Scope
+	}
+	for k, v := range segments {
+		if v != "" {
+			attributes[k] = v
+		}
+	}
+	return attributes, nil
+}
+
+// iamPolicyResourceMatches checks that every resource attribute and access tag of the policy matches the requested
+// resource, attributes the policy does not set are not restricted. The access tags of the resource are given as
+// key:value pairs, nil when they are not known, in which case a policy scoped to access tags cannot be evaluated
+func iamPolicyResourceMatches(resource *iampolicymanagementv1.V2PolicyResource, attributes map[string]string, tags []string) (bool, error) {
+	if resource == nil {
+		return false, nil
+	}
+	for _, a := range resource.Attributes {
+		if a.Key == nil || a.Operator == nil {
+			continue
+		}
+		value, ok := attributes[*a.Key]
+		matches, err := iamAttributeMatches(*a.Operator, a.Value, value, ok)
+		if err != nil {
+			return false, fmt.Errorf("resource attribute %s: %s", *a.Key, err)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	if len(resource.Tags) > 0 && tags == nil {
+		return false, fmt.Errorf("the policy is scoped to access tags and the access tags of the resource are not known")
+	}
+	for _, tag := range resource.Tags {
+		if tag.Key == nil || tag.Operator == nil {
+			continue
+		}
+		matches, err := iamTagMatches(*tag.Key, *tag.Operator, flex.StringValue(tag.Value), tags)
+		if err != nil {
+			return false, fmt.Errorf("access tag %s: %s", *tag.Key, err)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// iamTagMatches checks that one of the key:value access tags has the key and a value matching the policy tag
+func iamTagMatches(key, operator, value string, tags []string) (bool, error) {
+	if operator != "stringEquals" && operator != "stringMatch" {
+		return false, fmt.Errorf("unsupported operator %s", operator)
+	}
+	for _, tag := range tags {
+		parts := strings.SplitN(tag, ":", 2)
+		if len(parts) != 2 || parts[0] != key {
+			continue
+		}
+		if operator == "stringEquals" && parts[1] == value {
+			return true, nil
+		}
+		if operator == "stringMatch" && iamStringMatch(value, parts[1]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// iamPolicyRuleMatches evaluates the rule conditions of a policy, a policy without rule always applies
+func iamPolicyRuleMatches(rule iampolicymanagementv1.V2PolicyRuleIntf, attributes map[string]string, requestTime time.Time) (bool, error) {
+	r, ok := rule.(*iampolicymanagementv1.V2PolicyRule)
+	if !ok || r == nil {
+		return true, nil
+	}
+	if len(r.Conditions) == 0 {
+		if r.Key == nil || r.Operator == nil {
+			return true, nil
+		}
+		return iamConditionMatches(*r.Key, *r.Operator, r.Value, attributes, requestTime)
+	}
+
+	results := []bool{}
+	for _, cIntf := range r.Conditions {
+		c, ok := cIntf.(*iampolicymanagementv1.NestedCondition)
+		if !ok || c.Operator == nil {
+			return false, fmt.Errorf("unsupported rule condition %T", cIntf)
+		}
+		var matches bool
+		var err error
+		if len(c.Conditions) > 0 {
+			nestedResults := []bool{}
+			for _, nc := range c.Conditions {
+				if nc.Key == nil || nc.Operator == nil {
+					return false, fmt.Errorf("rule condition without key or operator")
+				}
+				m, err := iamConditionMatches(*nc.Key, *nc.Operator, nc.Value, attributes, requestTime)
+				if err != nil {
+					return false, err
+				}
+				nestedResults = append(nestedResults, m)
+			}
+			matches, err = iamCombineConditions(*c.Operator, nestedResults)
+		} else if c.Key != nil {
+			matches, err = iamConditionMatches(*c.Key, *c.Operator, c.Value, attributes, requestTime)
+		} else {
+			err = fmt.Errorf("rule condition without key")
+		}
+		if err != nil {
+			return false, err
+		}
+		results = append(results, matches)
+	}
+	operator := "and"
+	if r.Operator != nil {
+		operator = *r.Operator
+	}
+	return iamCombineConditions(operator, results)
+}
+
+func iamCombineConditions(operator string, results []bool) (bool, error) {
+	switch operator {
+	case "and":
+		for _, r := range results {
+			if !r {
+				return false, nil
+			}
+		}
+		return true, nil
+	case "or":
+		for _, r := range results {
+			if r {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported rule operator %s", operator)
+}
+
+func iamConditionMatches(key, operator string, value interface{}, attributes map[string]string, requestTime time.Time) (bool, error) {
+	switch {
+	case key == iamConditionCurrentTime:
+		return iamTimeConditionMatches(operator, value, requestTime)
+	case key == iamConditionCurrentDateTime:
+		return iamDateTimeConditionMatches(operator, value, requestTime)
+	case key == iamConditionDayOfWeek:
+		return iamDayOfWeekConditionMatches(operator, value, requestTime)
+	case strings.HasPrefix(key, iamConditionResourcePrefix) && strings.HasSuffix(key, "}}"):
+		name := strings.TrimSuffix(strings.TrimPrefix(key, iamConditionResourcePrefix), "}}")
+		actual, ok := attributes[name]
+		return iamAttributeMatches(operator, value, actual, ok)
+	}
+	return false, fmt.Errorf("unsupported rule condition key %s", key)
+}
+
+// iamAttributeMatches evaluates the string operators shared by resource attributes and rule conditions
+func iamAttributeMatches(operator string, expected interface{}, actual string, exists bool) (bool, error) {
+	switch operator {
+	case "stringExists":
+		want := fmt.Sprint(iamConditionValue(expected)) == "true"
+		return exists == want, nil
+	case "stringEquals", "stringEqualsAnyOf":
+		if !exists {
+			return false, nil
+		}
+		for _, v := range iamConditionValues(expected) {
+			if v == actual {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "stringMatch", "stringMatchAnyOf":
+		if !exists {
+			return false, nil
+		}
+		for _, v := range iamConditionValues(expected) {
+			if iamStringMatch(v, actual) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported operator %s", operator)
+}
+
+// iamStringMatch matches a value against a pattern where * matches any sequence of characters and ? a single character
+func iamStringMatch(pattern, value string) bool {
+	expr := regexp.QuoteMeta(pattern)
+	expr = strings.ReplaceAll(expr, `\*`, ".*")
+	expr = strings.ReplaceAll(expr, `\?`, ".")
+	matches, _ := regexp.MatchString("^"+expr+"$", value)
+	return matches
+}
+
+func iamTimeConditionMatches(operator string, expected interface{}, requestTime time.Time) (bool, error) {
+	t, err := time.Parse("15:04:05Z07:00", fmt.Sprint(iamConditionValue(expected)))
+	if err != nil {
+		return false, fmt.Errorf("invalid time condition value %v: %s", expected, err)
+	}
+	local := requestTime.In(t.Location())
+	actual := local.Hour()*3600 + local.Minute()*60 + local.Second()
+	want := t.Hour()*3600 + t.Minute()*60 + t.Second()
+
+	switch operator {
+	case "timeLessThan":
+		return actual < want, nil
+	case "timeLessThanOrEquals":
+		return actual <= want, nil
+	case "timeGreaterThan":
+		return actual > want, nil
+	case "timeGreaterThanOrEquals":
+		return actual >= want, nil
+	}
+	return false, fmt.Errorf("unsupported time operator %s", operator)
+}
+
+func iamDateTimeConditionMatches(operator string, expected interface{}, requestTime time.Time) (bool, error) {
+	t, err := time.Parse(time.RFC3339, fmt.Sprint(iamConditionValue(expected)))
+	if err != nil {
+		return false, fmt.Errorf("invalid date time condition value %v: %s", expected, err)
+	}
+
+	switch operator {
+	case "dateTimeLessThan":
+		return requestTime.Before(t), nil
+	case "dateTimeLessThanOrEquals":
+		return !requestTime.After(t), nil
+	case "dateTimeGreaterThan":
+		return requestTime.After(t), nil
+	case "dateTimeGreaterThanOrEquals":
+		return !requestTime.Before(t), nil
+	}
+	return false, fmt.Errorf("unsupported date time operator %s", operator)
+}
+
+// Days of the week are numbered from 1 (Monday) to 7 (Sunday) and carry a time zone offset, for example 1+00:00
+func iamDayOfWeekConditionMatches(operator string, expected interface{}, requestTime time.Time) (bool, error) {
+	if operator != "dayOfWeekEquals" && operator != "dayOfWeekAnyOf" {
+		return false, fmt.Errorf("unsupported day of week operator %s", operator)
+	}
+	for _, v := range iamConditionValues(expected) {
+		if len(v) < 2 {
+			return false, fmt.Errorf("invalid day of week condition value %s", v)
+		}
+		day, err := strconv.Atoi(v[:1])
+		if err != nil || day < 1 || day > 7 {
+			return false, fmt.Errorf("invalid day of week condition value %s", v)
+		}
+		zone, err := time.Parse("Z07:00", v[1:])
+		if err != nil {
+			return false, fmt.Errorf("invalid day of week condition value %s: %s", v, err)
+		}
+		weekday := int(requestTime.In(zone.Location()).Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		if weekday == day {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func iamConditionValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case *string:
+		if value != nil {
+			return *value
+		}
+		return ""
+	case *bool:
+		if value != nil {
+			return *value
+		}
+		return false
+	}
+	return v
+}
+
+func iamConditionValues(v interface{}) []string {
+	switch value := v.(type) {
+	case *[]string:
+		if value != nil {
+			return *value
+		}
+		return nil
+	case []string:
+		return value
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	case nil:
+		return nil
+	}
+	return []string{fmt.Sprint(iamConditionValue(v))}
+}
+
+// iamPolicyRoleIDs returns the role CRNs granted by a policy
+func iamPolicyRoleIDs(policy iampolicymanagementv1.V2PolicyTemplateMetaData) []string {
+	roleIDs := []string{}
+	var grant *iampolicymanagementv1.Grant
+	switch control := policy.Control.(type) {
+	case *iampolicymanagementv1.ControlResponse:
+		grant = control.Grant
+	case *iampolicymanagementv1.ControlResponseControl:
+		grant = control.Grant
+	}
+	if grant == nil {
+		return roleIDs
+	}
+	for _, role := range grant.Roles {
+		if role.RoleID != nil {
+			roleIDs = append(roleIDs, *role.RoleID)
+		}
+	}
+	return roleIDs
+}
@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIAMAccessCheckDecision(t *testing.T) {
+	assert.Equal(t, "allow", iamAccessCheckDecision(1, 0))
+	assert.Equal(t, "allow", iamAccessCheckDecision(1, 2))
+	assert.Equal(t, "indeterminate", iamAccessCheckDecision(0, 1))
+	assert.Equal(t, "deny", iamAccessCheckDecision(0, 0))
+}
+
+func TestIAMResourceAttributesFromCRN(t *testing.T) {
+	attributes, err := iamResourceAttributesFromCRN("crn:v1:bluemix:public:cloud-object-storage:global:a/abc123:def456:bucket:my-bucket")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{
+		"serviceName":     "cloud-object-storage",
+		"serviceInstance": "def456",
+		"resourceType":    "bucket",
+		"resource":        "my-bucket",
+		"accountId":       "abc123",
+	}, attributes)
+
+	attributes, err = iamResourceAttributesFromCRN("crn:v1:bluemix:public:kms:us-south:a/abc123:def456::")
+	assert.Nil(t, err)
+	assert.Equal(t, "us-south", attributes["region"])
+	_, ok := attributes["resourceType"]
+	assert.False(t, ok)
+
+	_, err = iamResourceAttributesFromCRN("not-a-crn")
+	assert.NotNil(t, err)
+}
+
+func TestIAMPolicyResourceMatches(t *testing.T) {
+	attributes := map[string]string{
+		"accountId":   "abc123",
+		"serviceName": "cloud-object-storage",
+		"resource":    "logs-2024",
+	}
+	resource := &iampolicymanagementv1.V2PolicyResource{
+		Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+			{Key: core.StringPtr("accountId"), Operator: core.StringPtr("stringEquals"), Value: "abc123"},
+			{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: "cloud-object-storage"},
+			{Key: core.StringPtr("resource"), Operator: core.StringPtr("stringMatch"), Value: "logs-*"},
+		},
+	}
+	matches, err := iamPolicyResourceMatches(resource, attributes, []string{})
+	assert.Nil(t, err)
+	assert.True(t, matches)
+
+	attributes["resource"] = "backups"
+	matches, err = iamPolicyResourceMatches(resource, attributes, []string{})
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	resource.Attributes = append(resource.Attributes, iampolicymanagementv1.V2PolicyResourceAttribute{
+		Key: core.StringPtr("resourceType"), Operator: core.StringPtr("stringExists"), Value: true,
+	})
+	attributes["resource"] = "logs-2024"
+	matches, err = iamPolicyResourceMatches(resource, attributes, []string{})
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	resource.Attributes[3].Operator = core.StringPtr("numberEquals")
+	_, err = iamPolicyResourceMatches(resource, attributes, []string{})
+	assert.NotNil(t, err)
+}
+
+func TestIAMPolicyResourceMatchesTags(t *testing.T) {
+	attributes := map[string]string{
+		"accountId":   "abc123",
+		"serviceName": "kms",
+	}
+	resource := &iampolicymanagementv1.V2PolicyResource{
+		Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+			{Key: core.StringPtr("accountId"), Operator: core.StringPtr("stringEquals"), Value: "abc123"},
+			{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: "kms"},
+		},
+		Tags: []iampolicymanagementv1.V2PolicyResourceTag{
+			{Key: core.StringPtr("env"), Operator: core.StringPtr("stringEquals"), Value: core.StringPtr("prod")},
+			{Key: core.StringPtr("team"), Operator: core.StringPtr("stringMatch"), Value: core.StringPtr("pay*")},
+		},
+	}
+
+	matches, err := iamPolicyResourceMatches(resource, attributes, []string{"env:prod", "team:payments", "owner:alice"})
+	assert.Nil(t, err)
+	assert.True(t, matches)
+
+	matches, err = iamPolicyResourceMatches(resource, attributes, []string{"env:dev", "team:payments"})
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	matches, err = iamPolicyResourceMatches(resource, attributes, []string{"env:prod"})
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	// Without the access tags of the resource a tag-scoped policy cannot be evaluated
+	matches, err = iamPolicyResourceMatches(resource, attributes, nil)
+	assert.NotNil(t, err)
+	assert.False(t, matches)
+
+	// A policy without access tags does not need them
+	resource.Tags = nil
+	matches, err = iamPolicyResourceMatches(resource, attributes, nil)
+	assert.Nil(t, err)
+	assert.True(t, matches)
+}
+
+func TestIAMStringMatch(t *testing.T) {
+	assert.True(t, iamStringMatch("logs/*", "logs/2024/01"))
+	assert.True(t, iamStringMatch("file-?.txt", "file-1.txt"))
+	assert.False(t, iamStringMatch("file-?.txt", "file-10.txt"))
+	assert.False(t, iamStringMatch("a.b", "axb"))
+}
+
+func TestIAMPolicyRuleMatches(t *testing.T) {
+	// Weekdays from 09:00 to 17:00 UTC
+	rule := &iampolicymanagementv1.V2PolicyRule{
+		Operator: core.StringPtr("and"),
+		Conditions: []iampolicymanagementv1.NestedConditionIntf{
+			&iampolicymanagementv1.NestedCondition{
+				Key:      core.StringPtr(iamConditionDayOfWeek),
+				Operator: core.StringPtr("dayOfWeekAnyOf"),
+				Value:    []interface{}{"1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"},
+			},
+			&iampolicymanagementv1.NestedCondition{
+				Key:      core.StringPtr(iamConditionCurrentTime),
+				Operator: core.StringPtr("timeGreaterThanOrEquals"),
+				Value:    "09:00:00+00:00",
+			},
+			&iampolicymanagementv1.NestedCondition{
+				Key:      core.StringPtr(iamConditionCurrentTime),
+				Operator: core.StringPtr("timeLessThanOrEquals"),
+				Value:    "17:00:00+00:00",
+			},
+		},
+	}
+
+	// 2024-01-03 is a Wednesday
+	matches, err := iamPolicyRuleMatches(rule, nil, time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.True(t, matches)
+
+	matches, err = iamPolicyRuleMatches(rule, nil, time.Date(2024, 1, 3, 18, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	// 2024-01-06 is a Saturday
+	matches, err = iamPolicyRuleMatches(rule, nil, time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	matches, err = iamPolicyRuleMatches(nil, nil, time.Now())
+	assert.Nil(t, err)
+	assert.True(t, matches)
+}
+
+func TestIAMPolicyRuleMatchesNestedConditions(t *testing.T) {
+	attributes := map[string]string{"path": "reports/2024"}
+	rule := &iampolicymanagementv1.V2PolicyRule{
+		Operator: core.StringPtr("or"),
+		Conditions: []iampolicymanagementv1.NestedConditionIntf{
+			&iampolicymanagementv1.NestedCondition{
+				Key:      core.StringPtr("{{resource.attributes.prefix}}"),
+				Operator: core.StringPtr("stringExists"),
+				Value:    true,
+			},
+			&iampolicymanagementv1.NestedCondition{
+				Operator: core.StringPtr("and"),
+				Conditions: []iampolicymanagementv1.RuleAttribute{
+					{Key: core.StringPtr("{{resource.attributes.path}}"), Operator: core.StringPtr("stringMatch"), Value: "reports/*"},
+					{Key: core.StringPtr(iamConditionCurrentDateTime), Operator: core.StringPtr("dateTimeLessThan"), Value: "2025-01-01T00:00:00+00:00"},
+				},
+			},
+		},
+	}
+
+	matches, err := iamPolicyRuleMatches(rule, attributes, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.True(t, matches)
+
+	matches, err = iamPolicyRuleMatches(rule, attributes, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.False(t, matches)
+
+	rule.Operator = core.StringPtr("xor")
+	_, err = iamPolicyRuleMatches(rule, attributes, time.Now())
+	assert.NotNil(t, err)
+}
+
+func TestIAMPolicyRoleIDs(t *testing.T) {
+	policy := iampolicymanagementv1.V2PolicyTemplateMetaData{
+		Control: &iampolicymanagementv1.ControlResponse{
+			Grant: &iampolicymanagementv1.Grant{
+				Roles: []iampolicymanagementv1.Roles{
+					{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::serviceRole:Reader")},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"crn:v1:bluemix:public:iam::::serviceRole:Reader"}, iamPolicyRoleIDs(policy))
+	assert.Empty(t, iamPolicyRoleIDs(iampolicymanagementv1.V2PolicyTemplateMetaData{}))
+}
@@ -838,6 +838,34 @@ func getAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
 	return userDetails.UserAccount, nil
 }
 
+// InviteUsersToAccessGroups invites users to the account as members of the given access groups, the same way as
+// ibm_iam_user_invite does
+func InviteUsersToAccessGroups(meta interface{}, accountID string, emails, accessGroups []string) error {
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return err
+	}
+	client := userManagement.UserInvite()
+
+	users := make([]v2.User, 0, len(emails))
+	for _, email := range emails {
+		users = append(users, v2.User{Email: email, AccountRole: MEMBER})
+	}
+	if len(users) == 0 {
+		return fmt.Errorf("[ERROR] Users email not provided")
+	}
+
+	inviteUserPayload := v2.UserInvite{Users: users}
+	if len(accessGroups) != 0 {
+		inviteUserPayload.AccessGroup = accessGroups
+	}
+	_, err = client.InviteUsers(accountID, inviteUserPayload)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error inviting users %v: %s", emails, err)
+	}
+	return nil
+}
+
 // getUserIAMID ...
 func getUserIAMID(d *schema.ResourceData, meta interface{}, user string) (string, error) {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyRegistrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID or alias of the key, all registrations of the instance are listed when not set",
+			},
+			"resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the registrations by resource CRN, supports the * wildcard",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"all_synced": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every registered resource is protected by the current version of its key",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources registered with the keys",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key protecting the resource",
+						},
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the registered resource",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key version protecting the resource",
+						},
+						"key_version_creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version protecting the resource was created",
+						},
+						"current_key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The latest version of the key",
+						},
+						"synced": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the resource is protected by the latest version of the key",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prevent_key_deletion": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegistrationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	resourceCRN := d.Get("resource_crn").(string)
+
+	registrations, err := getKMSKeyRegistrations(context, kpAPI, keyID, resourceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	currentVersions, err := getKMSKeyCurrentVersions(context, kpAPI, registrations)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	registrationList, allSynced := flattenKMSKeyRegistrations(registrations, currentVersions)
+
+	if keyID != "" {
+		d.SetId(fmt.Sprintf("%s:%s", instanceID, keyID))
+	} else {
+		d.SetId(instanceID)
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", d.Get("endpoint_type").(string))
+	d.Set("registrations", registrationList)
+	d.Set("all_synced", allSynced)
+
+	return nil
+}
+
+func getKMSKeyRegistrations(context context.Context, kpAPI *kp.Client, keyID, resourceCRN string) ([]kp.Registration, error) {
+	registrations, err := kpAPI.ListRegistrations(context, keyID, resourceCRN)
+	if err != nil {
+		if keyID != "" {
+			return nil, fmt.Errorf("[ERROR] Error listing registrations of key %s: %s", keyID, err)
+		}
+		return nil, fmt.Errorf("[ERROR] Error listing key registrations: %s", err)
+	}
+	if registrations == nil {
+		return []kp.Registration{}, nil
+	}
+	return registrations.Registrations, nil
+}
+
+// getKMSKeyCurrentVersions returns the latest version of every key used by the registrations
+func getKMSKeyCurrentVersions(context context.Context, kpAPI *kp.Client, registrations []kp.Registration) (map[string]string, error) {
+	currentVersions := make(map[string]string)
+	for _, registration := range registrations {
+		if _, ok := currentVersions[registration.KeyID]; ok {
+			continue
+		}
+		key, err := kpAPI.GetKeyMetadata(context, registration.KeyID)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Get Key failed with error while reading registrations: %s", err)
+		}
+		currentVersions[registration.KeyID] = ""
+		if key.KeyVersion != nil {
+			currentVersions[registration.KeyID] = key.KeyVersion.ID
+		}
+	}
+	return currentVersions, nil
+}
+
+func flattenKMSKeyRegistrations(registrations []kp.Registration, currentVersions map[string]string) ([]map[string]interface{}, bool) {
+	allSynced := true
+	registrationList := make([]map[string]interface{}, 0, len(registrations))
+	for _, registration := range registrations {
+		currentVersion := currentVersions[registration.KeyID]
+		synced := registration.KeyVersion.ID == currentVersion
+		if !synced {
+			allSynced = false
+		}
+
+		r := map[string]interface{}{
+			"key_id":                 registration.KeyID,
+			"resource_crn":           registration.ResourceCrn,
+			"key_version_id":         registration.KeyVersion.ID,
+			"current_key_version_id": currentVersion,
+			"synced":                 synced,
+			"description":            registration.Description,
+			"prevent_key_deletion":   registration.PreventKeyDeletion,
+			"created_by":             registration.CreatedBy,
+			"updated_by":             registration.UpdatedBy,
+		}
+		if registration.KeyVersion.CreationDate != nil {
+			r["key_version_creation_date"] = registration.KeyVersion.CreationDate.Format(time.RFC3339)
+		}
+		if registration.CreationDate != nil {
+			r["creation_date"] = registration.CreationDate.Format(time.RFC3339)
+		}
+		if registration.LastUpdateDate != nil {
+			r["last_updated"] = registration.LastUpdateDate.Format(time.RFC3339)
+		}
+		registrationList = append(registrationList, r)
+	}
+	return registrationList, allSynced
+}
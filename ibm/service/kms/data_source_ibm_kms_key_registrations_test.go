@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyRegistrationsDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	cosInstanceName := fmt.Sprintf("cos_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("bucket-%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKMSKeyRegistrationsDataSourceConfig(instanceName, cosInstanceName, bucketName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_key_registrations.test", "registrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.test", "registrations.0.resource_crn", "ibm_cos_bucket.bucket", "crn"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.test", "registrations.0.key_id", "ibm_kms_key.test", "key_id"),
+					resource.TestCheckResourceAttr("data.ibm_kms_key_registrations.test", "registrations.0.synced", "true"),
+					resource.TestCheckResourceAttr("data.ibm_kms_key_registrations.test", "all_synced", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKMSKeyRegistrationsDataSourceConfig(instanceName, cosInstanceName, bucketName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_resource_instance" "cos_instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_name = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_iam_authorization_policy" "policy" {
+		source_service_name         = "cloud-object-storage"
+		source_resource_instance_id = ibm_resource_instance.cos_instance.guid
+		target_service_name         = "kms"
+		target_resource_instance_id = ibm_resource_instance.kms_instance.guid
+		roles                       = ["Reader"]
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		depends_on           = [ibm_iam_authorization_policy.policy]
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.cos_instance.id
+		region_location      = "us-south"
+		storage_class        = "smart"
+		kms_key_crn          = ibm_kms_key.test.id
+	}
+	resource "ibm_kms_key_rotate" "rotate" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id = ibm_kms_key.test.key_id
+		wait_for_registrations_sync = true
+		depends_on = [ibm_cos_bucket.bucket]
+	}
+	data "ibm_kms_key_registrations" "test" {
+		instance_id = ibm_kms_key_rotate.rotate.instance_id
+		key_id = ibm_kms_key.test.key_id
+	}
+`, instanceName, cosInstanceName, keyName, bucketName)
+}
@@ -19,6 +19,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	kmsKeyStateActive    = "active"
+	kmsKeyStateSuspended = "suspended"
+)
+
 func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool {
 	// TF currently uses GUID. So just check when instance crn is passed as input it has same GUID in it.
 	return old == getInstanceIDFromCRN(new)
@@ -134,6 +139,13 @@ func ResourceIBMKmskey() *schema.Resource {
 				Description: "The date the key material expires. The date format follows RFC 3339. You can set an expiration date on any key on its creation. A key moves into the Deactivated state within one hour past its expiration date, if one is assigned. If you create a key without specifying an expiration date, the key does not expire",
 				ForceNew:    true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{kmsKeyStateActive, kmsKeyStateSuspended}),
+				Description:  "The state of the key, active or suspended. A suspended key cannot be used for cryptographic operations",
+			},
 			"instance_crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -217,6 +229,12 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(key.CRN)
+	if state, ok := d.GetOk("state"); ok && state.(string) != kmsKeyStateActive {
+		err = updateKMSKeyState(context.Background(), kpAPI, key.ID, state.(string))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMKmsKeyUpdate(d, meta)
 }
 
@@ -232,6 +250,17 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if d.HasChange("state") {
+		_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+		kpAPI, _, err := populateKPClient(d, meta, instanceID)
+		if err != nil {
+			return err
+		}
+		err = updateKMSKeyState(context.Background(), kpAPI, keyid, d.Get("state").(string))
+		if err != nil {
+			return err
+		}
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
@@ -353,6 +382,7 @@ func setKeyDetails(d *schema.ResourceData, meta interface{}, instanceID string,
 	d.Set(flex.ResourceCRN, key.CRN)
 	state := key.State
 	d.Set(flex.ResourceStatus, strconv.Itoa(state))
+	d.Set("state", kmsKeyStateToString(state))
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -391,6 +421,51 @@ func getInstanceAndKeyDataFromCRN(crn string) (instanceCRN string, instanceID st
 	return instanceCRN, instanceID, keyID
 }
 
+// Map the numeric key state to the name used by the Key Protect documentation
+func kmsKeyStateToString(state int) string {
+	switch state {
+	case 0:
+		return "pre-activation"
+	case 1:
+		return kmsKeyStateActive
+	case 2:
+		return kmsKeyStateSuspended
+	case 3:
+		return "deactivated"
+	case 5:
+		return "destroyed"
+	}
+	return strconv.Itoa(state)
+}
+
+// Enable or disable a key, keys that are already in the requested state are left as they are
+func updateKMSKeyState(ctx context.Context, kpAPI *kp.Client, keyID string, state string) error {
+	key, err := kpAPI.GetKeyMetadata(ctx, keyID)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Get Key failed with error while updating key state: %s", err)
+	}
+	current := kmsKeyStateToString(key.State)
+	if current == state {
+		return nil
+	}
+	if current != kmsKeyStateActive && current != kmsKeyStateSuspended {
+		return fmt.Errorf("[ERROR] Key %s is %s, only active and suspended keys can be set to %s", keyID, current, state)
+	}
+	switch state {
+	case kmsKeyStateSuspended:
+		err = kpAPI.DisableKey(ctx, keyID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while disabling key: %s", err)
+		}
+	case kmsKeyStateActive:
+		err = kpAPI.EnableKey(ctx, keyID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while enabling key: %s", err)
+		}
+	}
+	return nil
+}
+
 // Construct KMS URL
 func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
 
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKeyDualAuthDeleteApproval() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyDualAuthDeleteApprovalCreate,
+		ReadContext:   resourceIBMKmsKeyDualAuthDeleteApprovalRead,
+		DeleteContext: resourceIBMKmsKeyDualAuthDeleteApprovalDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or alias of the key to set for deletion",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the key",
+			},
+			"dual_auth_delete_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key has a dual authorization delete policy",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyDualAuthDeleteApprovalCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("key_id").(string)
+	key, err := kpAPI.GetKeyMetadata(context, id)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while setting key for deletion: %s", err)
+	}
+	if key.DualAuthDelete == nil || key.DualAuthDelete.Enabled == nil || !*key.DualAuthDelete.Enabled {
+		return diag.Errorf("Key %s does not have a dual authorization delete policy", id)
+	}
+
+	err = kpAPI.InitiateDualAuthDelete(context, key.ID)
+	if err != nil {
+		return diag.Errorf("Failed to set key %s for deletion: %s", id, err)
+	}
+
+	d.SetId(key.CRN)
+	return resourceIBMKmsKeyDualAuthDeleteApprovalRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyDualAuthDeleteApprovalRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyid)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && (kpError.StatusCode == 404 || kpError.StatusCode == 409) {
+			log.Printf("[WARN] Key %s not found, removing dual authorization delete approval from state", keyid)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Get Key failed with error while reading dual authorization delete approval: %s", err)
+	} else if key.State == 5 { //Refers to Deleted state of the Key
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	if _, ok := d.GetOk("key_id"); !ok {
+		d.Set("key_id", keyid)
+	}
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("crn", key.CRN)
+	d.Set("dual_auth_delete_enabled", key.DualAuthDelete != nil && key.DualAuthDelete.Enabled != nil && *key.DualAuthDelete.Enabled)
+
+	return nil
+}
+
+// Deleting the approval unsets the key for deletion, unless the key was deleted in the meantime
+func resourceIBMKmsKeyDualAuthDeleteApprovalDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyid)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && (kpError.StatusCode == 404 || kpError.StatusCode == 409) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Get Key failed with error while deleting dual authorization delete approval: %s", err)
+	}
+	if key.State != 5 {
+		err = kpAPI.CancelDualAuthDelete(context, keyid)
+		if err != nil {
+			return diag.Errorf("Failed to unset key %s for deletion: %s", keyid, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSResource_Key_DualAuthDeleteApproval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceDualAuthDeleteApprovalConfig(acc.KmsInstanceID, acc.KmsDualAuthKeyID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_dual_auth_delete_approval.approval", "key_id", acc.KmsDualAuthKeyID),
+					resource.TestCheckResourceAttr("ibm_kms_key_dual_auth_delete_approval.approval", "dual_auth_delete_enabled", "true"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_dual_auth_delete_approval.approval", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSResource_Key_DualAuthDeleteApproval_NoPolicy(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMKmsResourceDualAuthDeleteApprovalNoPolicyConfig(instanceName, keyName),
+				ExpectError: regexp.MustCompile("does not have a dual authorization delete policy"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsResourceDualAuthDeleteApprovalConfig(instanceID, keyID string) string {
+	return fmt.Sprintf(`
+	resource "ibm_kms_key_dual_auth_delete_approval" "approval" {
+		instance_id = "%s"
+		key_id = "%s"
+	}
+`, instanceID, keyID)
+}
+
+func testAccCheckIBMKmsResourceDualAuthDeleteApprovalNoPolicyConfig(instanceName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_name = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_dual_auth_delete_approval" "approval" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id = ibm_kms_key.test.key_id
+	}
+`, instanceName, keyName)
+}
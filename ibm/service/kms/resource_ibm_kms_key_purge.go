@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmsKeyPurgeDelay is the time after the deletion of a key from which it can be purged
+const kmsKeyPurgeDelay = 4 * time.Hour
+
+func ResourceIBMKmsKeyPurge() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyPurgeCreate,
+		ReadContext:   resourceIBMKmsKeyPurgeRead,
+		DeleteContext: resourceIBMKmsKeyPurgeDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the deleted key to purge",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the purged key",
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the purged key",
+			},
+			"deletion_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the key was deleted",
+			},
+			"purged_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the key was purged",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyPurgeCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("key_id").(string)
+	key, err := kpAPI.GetKeyMetadata(context, id)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while purging key: %s", err)
+	}
+	if key.State != 5 {
+		return diag.Errorf("Key %s is %s, only deleted keys can be purged", id, kmsKeyStateToString(key.State))
+	}
+
+	purgeAllowedFrom := kmsKeyPurgeAllowedFrom(key)
+	if wait := time.Until(purgeAllowedFrom); wait > 0 {
+		if wait > d.Timeout(schema.TimeoutCreate) {
+			return diag.Errorf("Key %s can only be purged from %s, 4 hours after its deletion. Apply again after that time, or raise the create timeout to wait for it", id, purgeAllowedFrom.Format(time.RFC3339))
+		}
+		log.Printf("[INFO] Waiting until %s to purge key %s", purgeAllowedFrom.Format(time.RFC3339), id)
+		select {
+		case <-time.After(wait):
+		case <-context.Done():
+			return diag.Errorf("Waiting to purge key %s was cancelled: %s", id, context.Err())
+		}
+	}
+
+	_, err = kpAPI.PurgeKey(context, key.ID, kp.ReturnMinimal)
+	if err != nil {
+		return diag.Errorf("Failed to purge key %s: %s", id, err)
+	}
+
+	d.SetId(key.CRN)
+	d.Set("crn", key.CRN)
+	d.Set("key_name", key.Name)
+	if key.DeletionDate != nil {
+		d.Set("deletion_date", key.DeletionDate.Format(time.RFC3339))
+	}
+	d.Set("purged_at", time.Now().UTC().Format(time.RFC3339))
+	return resourceIBMKmsKeyPurgeRead(context, d, meta)
+}
+
+// kmsKeyPurgeAllowedFrom returns the time from which the deleted key can be purged
+func kmsKeyPurgeAllowedFrom(key *kp.Key) time.Time {
+	if key.PurgeAllowedFrom != nil {
+		return *key.PurgeAllowedFrom
+	}
+	if key.PurgeAllowed != nil && *key.PurgeAllowed {
+		return time.Time{}
+	}
+	if key.DeletionDate != nil {
+		return key.DeletionDate.Add(kmsKeyPurgeDelay)
+	}
+	return time.Time{}
+}
+
+// A purged key can't be read anymore, only the instance attributes are refreshed
+func resourceIBMKmsKeyPurgeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyid)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+
+	return nil
+}
+
+// Purging can't be undone, deleting only removes the purge from the state
+func resourceIBMKmsKeyPurgeDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Println("Warning:  `terraform destroy` cannot undo the purge of a key but only clears the state file.")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKeyRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRestoreCreate,
+		ReadContext:   resourceIBMKmsKeyRestoreRead,
+		DeleteContext: resourceIBMKmsKeyRestoreDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the deleted key to restore",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the key",
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the key",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRestoreCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("key_id").(string)
+	key, err := kpAPI.GetKeyMetadata(context, id)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while restoring key: %s", err)
+	}
+	if key.State != 5 {
+		return diag.Errorf("Key %s is %s, only deleted keys can be restored", id, kmsKeyStateToString(key.State))
+	}
+
+	_, err = kpAPI.RestoreKey(context, key.ID)
+	if err != nil {
+		return diag.Errorf("Failed to restore key %s: %s", id, err)
+	}
+
+	d.SetId(key.CRN)
+	return resourceIBMKmsKeyRestoreRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyRestoreRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(d.Id())
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyid)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && (kpError.StatusCode == 404 || kpError.StatusCode == 409) {
+			log.Printf("[WARN] Key %s not found, removing key restore from state", keyid)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Get Key failed with error while reading key restore: %s", err)
+	} else if key.State == 5 { //Refers to Deleted state of the Key, the key was deleted again after the restore
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyid)
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("crn", key.CRN)
+	d.Set("key_name", key.Name)
+	d.Set("state", kmsKeyStateToString(key.State))
+
+	return nil
+}
+
+// The restored key is left in place, deleting only removes the restore from the state
+func resourceIBMKmsKeyRestoreDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Println("Warning:  `terraform destroy` does not delete the restored key but only clears the state file.")
+	d.SetId("")
+	return nil
+}
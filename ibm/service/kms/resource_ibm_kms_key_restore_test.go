@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSResource_Key_Restore(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceKeyRestoreConfig(acc.KmsInstanceID, acc.KmsDeletedKeyID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_restore.restore", "key_id", acc.KmsDeletedKeyID),
+					resource.TestCheckResourceAttr("ibm_kms_key_restore.restore", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_restore.restore", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSResource_Key_Restore_ActiveKey(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMKmsResourceKeyRestoreActiveKeyConfig(instanceName, keyName),
+				ExpectError: regexp.MustCompile("only deleted keys can be restored"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsResourceKeyRestoreConfig(instanceID, keyID string) string {
+	return fmt.Sprintf(`
+	resource "ibm_kms_key_restore" "restore" {
+		instance_id = "%s"
+		key_id = "%s"
+	}
+`, instanceID, keyID)
+}
+
+func testAccCheckIBMKmsResourceKeyRestoreActiveKeyConfig(instanceName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_name = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_restore" "restore" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id = ibm_kms_key.test.key_id
+	}
+`, instanceName, keyName)
+}
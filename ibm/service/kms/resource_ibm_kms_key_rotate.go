@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	kmsKeyRegistrationsSyncing = "syncing"
+	kmsKeyRegistrationsSynced  = "synced"
+)
+
+func ResourceIBMKmsKeyRotate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRotateCreate,
+		ReadContext:   resourceIBMKmsKeyRotateRead,
+		DeleteContext: resourceIBMKmsKeyRotateDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or alias of the root key to rotate",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new key material for imported root keys",
+			},
+			"encrypted_nonce": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "iv_value"},
+				Description:  "The encrypted nonce of the new key material, only for securely imported root keys",
+			},
+			"iv_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "encrypted_nonce"},
+				Description:  "The initialization vector of the new key material, only for securely imported root keys",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, rotates the key again",
+			},
+			"wait_for_registrations_sync": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Wait until every resource registered with the key is protected by the new key version",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the key",
+			},
+			"key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The key version created by the rotation",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated",
+			},
+			"all_synced": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every registered resource is protected by the current key version",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources registered with the key and the key version protecting them",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the registered resource",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key version protecting the resource",
+						},
+						"synced": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the resource is protected by the current key version",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRotateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("key_id").(string)
+	key, err := kpAPI.GetKeyMetadata(context, id)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error while rotating key: %s", err)
+	}
+	if key.Extractable {
+		return diag.Errorf("Key %s is a standard key, only root keys can be rotated", id)
+	}
+
+	var newKey *kp.KeyPayload
+	if v, ok := d.GetOk("payload"); ok {
+		payload := kp.NewKeyPayload(v.(string), d.Get("encrypted_nonce").(string), d.Get("iv_value").(string))
+		newKey = &payload
+	}
+
+	err = kpAPI.RotateV2(context, key.ID, newKey)
+	if err != nil {
+		return diag.Errorf("Failed to rotate key %s: %s", id, err)
+	}
+
+	key, err = kpAPI.GetKeyMetadata(context, key.ID)
+	if err != nil {
+		return diag.Errorf("Get Key failed with error after rotating key: %s", err)
+	}
+	if key.KeyVersion == nil || key.KeyVersion.ID == "" {
+		return diag.Errorf("Key %s was rotated but no key version was returned", id)
+	}
+
+	d.SetId(fmt.Sprintf("%s:version:%s", key.CRN, key.KeyVersion.ID))
+
+	if d.Get("wait_for_registrations_sync").(bool) {
+		_, err = waitForKMSKeyRegistrationsSync(context, kpAPI, key.ID, key.KeyVersion.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("Error waiting for the resources registered with key %s to sync to version %s: %s", id, key.KeyVersion.ID, err)
+		}
+	}
+
+	return resourceIBMKmsKeyRotateRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyRotateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := strings.Split(d.Id(), ":version:")
+	if len(id) < 2 {
+		return diag.Errorf("Incorrect ID %s: Id should be a combination of keyCRN:version:keyVersionID", d.Id())
+	}
+	_, instanceID, keyid := getInstanceAndKeyDataFromCRN(id[0])
+	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	key, err := kpAPI.GetKeyMetadata(context, keyid)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && (kpError.StatusCode == 404 || kpError.StatusCode == 409) {
+			log.Printf("[WARN] Key %s not found, removing key rotation from state", keyid)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Get Key failed with error while reading key rotation: %s", err)
+	} else if key.State == 5 { //Refers to Deleted state of the Key
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	if _, ok := d.GetOk("key_id"); !ok {
+		d.Set("key_id", keyid)
+	}
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		d.Set("endpoint_type", "private")
+	} else {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("crn", key.CRN)
+	d.Set("key_version_id", id[1])
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
+
+	registrations, err := getKMSKeyRegistrations(context, kpAPI, keyid, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	currentVersion := ""
+	if key.KeyVersion != nil {
+		currentVersion = key.KeyVersion.ID
+	}
+	allSynced := true
+	registrationList := make([]map[string]interface{}, 0, len(registrations))
+	for _, registration := range registrations {
+		synced := registration.KeyVersion.ID == currentVersion
+		if !synced {
+			allSynced = false
+		}
+		registrationList = append(registrationList, map[string]interface{}{
+			"resource_crn":   registration.ResourceCrn,
+			"key_version_id": registration.KeyVersion.ID,
+			"synced":         synced,
+		})
+	}
+	d.Set("registrations", registrationList)
+	d.Set("all_synced", allSynced)
+
+	return nil
+}
+
+// A rotation cannot be undone, deleting only removes it from the state
+func resourceIBMKmsKeyRotateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Println("Warning:  `terraform destroy` does not revert the key rotation but only clears the state file.")
+	d.SetId("")
+	return nil
+}
+
+func waitForKMSKeyRegistrationsSync(context context.Context, kpAPI *kp.Client, keyID, keyVersionID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kmsKeyRegistrationsSyncing},
+		Target:  []string{kmsKeyRegistrationsSynced},
+		Refresh: func() (interface{}, string, error) {
+			registrations, err := getKMSKeyRegistrations(context, kpAPI, keyID, "")
+			if err != nil {
+				return nil, "", err
+			}
+			for _, registration := range registrations {
+				if registration.KeyVersion.ID != keyVersionID {
+					log.Printf("[DEBUG] Registration %s of key %s is still on key version %s", registration.ResourceCrn, keyID, registration.KeyVersion.ID)
+					return registrations, kmsKeyRegistrationsSyncing, nil
+				}
+			}
+			return registrations, kmsKeyRegistrationsSynced, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
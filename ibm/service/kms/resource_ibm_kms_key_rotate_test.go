@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSResource_Key_Rotate(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceKeyRotateConfig(instanceName, keyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotate.rotate", "key_version_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotate.rotate", "last_rotate_date"),
+					resource.TestCheckResourceAttr("ibm_kms_key_rotate.rotate", "all_synced", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceKeyRotateConfig(instanceName, keyName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_rotate.rotate", "triggers.rotation", "2"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotate.rotate", "key_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSResource_Key_Rotate_StandardKey(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMKmsResourceKeyRotateStandardKeyConfig(instanceName, keyName),
+				ExpectError: regexp.MustCompile("only root keys can be rotated"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsResourceKeyRotateConfig(instanceName, keyName, trigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_name = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_rotate" "rotate" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id = ibm_kms_key.test.key_id
+		wait_for_registrations_sync = true
+		triggers = {
+			rotation = "%s"
+		}
+	}
+`, instanceName, keyName, trigger)
+}
+
+func testAccCheckIBMKmsResourceKeyRotateStandardKeyConfig(instanceName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_name = "%s"
+		standard_key = true
+		force_delete = true
+	}
+	resource "ibm_kms_key_rotate" "rotate" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id = ibm_kms_key.test.key_id
+	}
+`, instanceName, keyName)
+}
@@ -154,6 +154,43 @@ func TestAccIBMKMSResource_InvalidExpDate(t *testing.T) {
 	})
 }
 
+func TestAccIBMKMSResource_State(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_key"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceConfig(instanceName, resourceName, keyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "active"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceConfigState(instanceName, resourceName, keyName, "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "suspended"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceConfigState(instanceName, resourceName, keyName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "active"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceConfigState(instanceName, "ibm_kms_key_with_policy_overrides", keyName, "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_with_policy_overrides.test", "state", "suspended"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMKmsResourceConfig(instanceName, resource, KeyName string, standard_key bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
@@ -189,6 +226,24 @@ func testAccCheckIBMKmsResourceConfigDescription(instanceName, resource, KeyName
 `, instanceName, resource, KeyName, standard_key, description)
 }
 
+func testAccCheckIBMKmsResourceConfigState(instanceName, resource, KeyName string, state string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	  }
+	  resource "%s" "test" {
+		instance_id = "${ibm_resource_instance.kms_instance.guid}"
+		key_name = "%s"
+		standard_key = false
+		state = "%s"
+		force_delete = true
+	}
+`, instanceName, resource, KeyName, state)
+}
+
 func testAccCheckIBMKmsResourceImportConfig(instanceName, resource, KeyName string, standard_key bool, payload string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
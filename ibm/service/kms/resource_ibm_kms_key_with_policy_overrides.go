@@ -116,6 +116,13 @@ func ResourceIBMKmsKeyWithPolicyOverrides() *schema.Resource {
 				Description: "The date the key material expires. The date format follows RFC 3339. You can set an expiration date on any key on its creation. A key moves into the Deactivated state within one hour past its expiration date, if one is assigned. If you create a key without specifying an expiration date, the key does not expire",
 				ForceNew:    true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{kmsKeyStateActive, kmsKeyStateSuspended}),
+				Description:  "The state of the key, active or suspended. A suspended key cannot be used for cryptographic operations",
+			},
 			"instance_crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -207,6 +214,12 @@ func resourceIBMKmsKeyWithPolicyOverridesCreate(context context.Context, d *sche
 	}
 
 	d.SetId(key.CRN)
+	if state, ok := d.GetOk("state"); ok && state.(string) != kmsKeyStateActive {
+		err = updateKMSKeyState(context, kpAPI, key.ID, state.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceIBMKmsKeyWithPolicyOverridesUpdate(context, d, meta)
 }
 
@@ -234,6 +247,17 @@ func resourceIBMKmsKeyWithPolicyOverridesUpdate(context context.Context, d *sche
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if d.HasChange("state") {
+		_, instanceID, key_id := getInstanceAndKeyDataFromCRN(d.Id())
+		kpAPI, _, err := populateKPClient(d, meta, instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateKMSKeyState(context, kpAPI, key_id, d.Get("state").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if d.HasChange("rotation") || d.HasChange("dual_auth_delete") {
 		_, rotationOk := d.GetOk("rotation")
 		_, dualAuthOk := d.GetOk("dual_auth_delete")
@@ -4,11 +4,13 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -18,10 +20,24 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 const (
 	workerDesired = "deployed"
+
+	workerPoolUpdateStrategyRecreate = "recreate"
+	workerPoolUpdateStrategyRotation = "rotation"
+	workerPoolRotatedSuffix          = "-rotated"
+	workerPoolIDNodeLabel            = "ibm-cloud.kubernetes.io/worker-pool-id"
+	workerPoolDraining               = "draining"
+	workerPoolDrained                = "drained"
 )
 
 func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
@@ -35,9 +51,16 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerVpcWorkerPoolUpdateStrategyDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"cluster": {
 				Type:        schema.TypeString,
@@ -52,7 +75,6 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 			"flavor": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "cluster node falvor",
 			},
 
@@ -159,10 +181,25 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "The operating system of the workers in the worker pool.",
 			},
 
+			"update_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  workerPoolUpdateStrategyRecreate,
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_vpc_worker_pool",
+					"update_strategy"),
+				Description: "How flavor and operating_system changes are applied. recreate destroys and recreates the worker pool, rotation creates a replacement pool, drains the old pool and then deletes it",
+			},
+
+			"kube_config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of downloaded cluster config, used to cordon and drain the old worker pool nodes when update_strategy is rotation",
+			},
+
 			"secondary_storage": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -259,6 +296,13 @@ func ResourceIBMContainerVPCWorkerPoolValidator() *validate.ResourceValidator {
 			Required:                   true,
 			CloudDataType:              "cluster",
 			CloudDataRange:             []string{"resolved_to:id"}})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "update_strategy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s,%s", workerPoolUpdateStrategyRecreate, workerPoolUpdateStrategyRotation)})
 
 	containerVPCWorkerPoolTaintsValidator := validate.ResourceValidator{ResourceName: "ibm_container_vpc_worker_pool", Schema: validateSchema}
 	return &containerVPCWorkerPoolTaintsValidator
@@ -294,6 +338,38 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 
 	}
 
+	params := expandVpcWorkerPoolRequest(d, clusterNameorID, d.Get("worker_pool_name").(string))
+
+	workerPoolsAPI := wpClient.WorkerPools()
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
+
+	//wait for workerpool availability
+	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+	}
+
+	if taintRes, ok := d.GetOk("taints"); ok {
+		if err := updateWorkerpoolTaints(d, meta, clusterNameorID, params.Name, taintRes.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+}
+
+// expandVpcWorkerPoolRequest builds the create request for a worker pool named workerPoolName from the resource configuration
+func expandVpcWorkerPoolRequest(d *schema.ResourceData, clusterNameorID, workerPoolName string) v2.WorkerPoolRequest {
 	var zonei []interface{}
 
 	zone := []v2.Zone{}
@@ -314,7 +390,7 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 	params := v2.WorkerPoolRequest{
 		Cluster: clusterNameorID,
 		CommonWorkerPoolConfig: v2.CommonWorkerPoolConfig{
-			Name:        d.Get("worker_pool_name").(string),
+			Name:        workerPoolName,
 			VpcID:       d.Get("vpc_id").(string),
 			Flavor:      d.Get("flavor").(string),
 			WorkerCount: d.Get("worker_count").(int),
@@ -364,42 +440,28 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 		params.HostPoolID = hpid.(string)
 	}
 
-	workerPoolsAPI := wpClient.WorkerPools()
-	targetEnv, err := getVpcClusterTargetHeader(d, meta)
-	if err != nil {
-		return err
-	}
+	return params
+}
 
-	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
+func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	clusterNameOrID := d.Get("cluster").(string)
+	parts, err := flex.IdParts(d.Id())
 	if err != nil {
 		return err
 	}
+	// The pool name can differ from worker_pool_name after a rotation, so address the pool by its ID
+	workerPoolID := parts[1]
 
-	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
-
-	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
-	}
-
-	if taintRes, ok := d.GetOk("taints"); ok {
-		if err := updateWorkerpoolTaints(d, meta, clusterNameorID, params.Name, taintRes.(*schema.Set).List()); err != nil {
+	if d.Get("update_strategy").(string) == workerPoolUpdateStrategyRotation && (d.HasChange("flavor") || d.HasChange("operating_system")) {
+		// The replacement pool is created from the full configuration, so no further updates are needed
+		if err := rotateVpcWorkerPool(d, meta, clusterNameOrID, workerPoolID); err != nil {
 			return err
 		}
+		return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 	}
 
-	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
-}
-
-func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	clusterNameOrID := d.Get("cluster").(string)
-	workerPoolName := d.Get("worker_pool_name").(string)
-
 	if d.HasChange("labels") {
 		clusterNameOrID := d.Get("cluster").(string)
-		workerPoolName := d.Get("worker_pool_name").(string)
-
 		labels := make(map[string]string)
 		if l, ok := d.GetOk("labels"); ok {
 			for k, v := range l.(map[string]interface{}) {
@@ -417,7 +479,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
-		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolName, labels, Env)
+		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolID, labels, Env)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the labels: %s", err)
 		}
@@ -428,14 +490,13 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if taintRes, ok := d.GetOk("taints"); ok {
 			taints = taintRes.(*schema.Set).List()
 		}
-		if err := updateWorkerpoolTaints(d, meta, clusterNameOrID, workerPoolName, taints); err != nil {
+		if err := updateWorkerpoolTaints(d, meta, clusterNameOrID, workerPoolID, taints); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("worker_count") {
 		clusterNameOrID := d.Get("cluster").(string)
-		workerPoolName := d.Get("worker_pool_name").(string)
 		count := d.Get("worker_count").(int)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
@@ -447,7 +508,7 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
 
-		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolName, count, Env)
+		err = ClusterClient.WorkerPools().ResizeWorkerPool(clusterNameOrID, workerPoolID, count, Env)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the worker_count %d: %s", count, err)
 		}
@@ -455,7 +516,6 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 
 	if d.HasChange("zones") {
 		clusterID := d.Get("cluster").(string)
-		workerPoolName := d.Get("worker_pool_name").(string)
 		targetEnv, err := getVpcClusterTargetHeader(d, meta)
 		if err != nil {
 			return err
@@ -482,13 +542,13 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 					Cluster:      clusterID,
 					Id:           newZone["name"].(string),
 					SubnetID:     newZone["subnet_id"].(string),
-					WorkerPoolID: workerPoolName,
+					WorkerPoolID: workerPoolID,
 				}
 				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error adding zone to conatiner vpc cluster: %s", err)
 				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
+				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolID, d.Timeout(schema.TimeoutCreate), targetEnv)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
 				}
@@ -503,13 +563,13 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 					return err
 				}
 				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
-				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
+				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolID, Env)
 				if err != nil {
 					return fmt.Errorf("[ERROR] Error deleting zone to conatiner vpc cluster: %s", err)
 				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolID, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
 				if err != nil {
-					return fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+					return fmt.Errorf("[ERROR] Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolID, clusterID, err)
 				}
 			}
 		}
@@ -606,7 +666,10 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("[ERROR] Error retrieving conatiner vpc cluster: %s", err)
 	}
 
-	d.Set("worker_pool_name", workerPool.PoolName)
+	// Keep the configured name when the pool was renamed by a rotation
+	if name := d.Get("worker_pool_name").(string); workerPool.PoolName != name+workerPoolRotatedSuffix {
+		d.Set("worker_pool_name", workerPool.PoolName)
+	}
 	d.Set("flavor", workerPool.Flavor)
 	d.Set("worker_count", workerPool.WorkerCount)
 	d.Set("worker_pool_id", workerPoolID)
@@ -785,3 +848,233 @@ func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPo
 		return workerFields, workerDeleteState, nil
 	}
 }
+
+func resourceIBMContainerVpcWorkerPoolUpdateStrategyDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	rotate := diff.Get("update_strategy").(string) == workerPoolUpdateStrategyRotation
+	for _, key := range []string{"flavor", "operating_system"} {
+		if !diff.HasChange(key) {
+			continue
+		}
+		if !rotate {
+			if err := diff.ForceNew(key); err != nil {
+				return err
+			}
+			continue
+		}
+		if diff.NewValueKnown("kube_config_path") && diff.Get("kube_config_path").(string) == "" {
+			return fmt.Errorf("[ERROR] kube_config_path argument must be specified to change %s with the %s update_strategy", key, workerPoolUpdateStrategyRotation)
+		}
+		if err := diff.SetNewComputed("worker_pool_id"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rotateVpcWorkerPool replaces the worker pool with a new pool built from the current configuration.
+// The old pool's nodes are cordoned and drained once the new workers are normal, then the old pool is deleted.
+func rotateVpcWorkerPool(d *schema.ResourceData, meta interface{}, clusterNameOrID, oldWorkerPoolID string) error {
+	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	workerPoolsAPI := wpClient.WorkerPools()
+
+	config, err := clientcmd.BuildConfigFromFlags("", d.Get("kube_config_path").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Invalid kubeconfig, failed to set context: %s", err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Invalid kubeconfig, failed to create clientset: %s", err)
+	}
+
+	oldWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterNameOrID, oldWorkerPoolID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pool (%s) of cluster (%s): %s", oldWorkerPoolID, clusterNameOrID, err)
+	}
+
+	// Pool names are unique within a cluster, so alternate between the configured name and its rotated form
+	workerPoolName := d.Get("worker_pool_name").(string)
+	if oldWorkerPool.PoolName == workerPoolName {
+		workerPoolName = workerPoolName + workerPoolRotatedSuffix
+	}
+
+	// A replacement pool left behind by an interrupted rotation is reused
+	var newWorkerPoolID string
+	newWorkerPool, err := workerPoolsAPI.GetWorkerPool(clusterNameOrID, workerPoolName, targetEnv)
+	if err == nil {
+		log.Printf("[INFO] Reusing replacement worker pool %s (%s) of cluster %s", workerPoolName, newWorkerPool.ID, clusterNameOrID)
+		newWorkerPoolID = newWorkerPool.ID
+	} else {
+		if apiErr, ok := err.(bmxerror.RequestFailure); !ok || apiErr.StatusCode() != 404 {
+			return fmt.Errorf("[ERROR] Error retrieving worker pool (%s) of cluster (%s): %s", workerPoolName, clusterNameOrID, err)
+		}
+		log.Printf("[INFO] Rotating worker pool %s of cluster %s to worker pool %s", oldWorkerPool.PoolName, clusterNameOrID, workerPoolName)
+		res, err := workerPoolsAPI.CreateWorkerPool(expandVpcWorkerPoolRequest(d, clusterNameOrID, workerPoolName), targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating replacement worker pool (%s) of cluster (%s): %s", workerPoolName, clusterNameOrID, err)
+		}
+		newWorkerPoolID = res.ID
+	}
+
+	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameOrID, newWorkerPoolID, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for replacement workerpool (%s) to become ready: %s", newWorkerPoolID, err)
+	}
+
+	if taintRes, ok := d.GetOk("taints"); ok {
+		if err := updateWorkerpoolTaints(d, meta, clusterNameOrID, newWorkerPoolID, taintRes.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	_, err = waitForVpcWorkerPoolNormal(meta, clusterNameOrID, newWorkerPoolID, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for workers of replacement workerpool (%s) to become normal: %s", newWorkerPoolID, err)
+	}
+
+	_, err = waitForVpcWorkerPoolDrained(clientset, oldWorkerPoolID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error draining the nodes of worker pool (%s): %s", oldWorkerPoolID, err)
+	}
+
+	err = workerPoolsAPI.DeleteWorkerPool(clusterNameOrID, oldWorkerPoolID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting worker pool (%s) of cluster (%s): %s", oldWorkerPoolID, clusterNameOrID, err)
+	}
+	_, err = WaitForVpcWorkerDelete(clusterNameOrID, oldWorkerPoolID, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", oldWorkerPoolID, clusterNameOrID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameOrID, newWorkerPoolID))
+	return nil
+}
+
+func waitForVpcWorkerPoolNormal(meta interface{}, clusterNameOrID, workerPoolID string, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Waiting for workers of workerpool (%s) to be normal.", workerPoolID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{workerProvisioning},
+		Target:     []string{workerNormal},
+		Refresh:    vpcWorkerPoolNormalRefreshFunc(wpClient.Workers(), clusterNameOrID, workerPoolID, target),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func vpcWorkerPoolNormalRefreshFunc(client v2.Workers, clusterNameOrID, workerPoolID string, target v2.ClusterTargetHeader) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		workerFields, err := client.ListByWorkerPool(clusterNameOrID, workerPoolID, false, target)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		}
+		if len(workerFields) == 0 {
+			return workerFields, workerProvisioning, nil
+		}
+		for _, e := range workerFields {
+			if e.Health.State != workerNormal {
+				log.Printf("worker: %s health: %s", e.ID, e.Health.State)
+				return workerFields, workerProvisioning, nil
+			}
+		}
+		return workerFields, workerNormal, nil
+	}
+}
+
+func waitForVpcWorkerPoolDrained(clientset *kubernetes.Clientset, workerPoolID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for nodes of workerpool (%s) to be drained.", workerPoolID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{workerPoolDraining},
+		Target:     []string{workerPoolDrained},
+		Refresh:    vpcWorkerPoolDrainRefreshFunc(clientset, workerPoolID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// vpcWorkerPoolDrainRefreshFunc cordons the nodes of the worker pool and evicts their pods until none are left.
+// Evictions blocked by a pod disruption budget are retried on the next refresh.
+func vpcWorkerPoolDrainRefreshFunc(clientset *kubernetes.Clientset, workerPoolID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ctx := context.TODO()
+		nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", workerPoolIDNodeLabel, workerPoolID),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error listing nodes: %s", err)
+		}
+		remaining := 0
+		for i := range nodes.Items {
+			node := &nodes.Items[i]
+			if !node.Spec.Unschedulable {
+				node.Spec.Unschedulable = true
+				if _, err := clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error cordoning node %s: %s", node.Name, err)
+				}
+			}
+			pods, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+				FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name),
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error listing pods of node %s: %s", node.Name, err)
+			}
+			for _, pod := range pods.Items {
+				if !isEvictablePod(pod) {
+					continue
+				}
+				remaining++
+				if pod.DeletionTimestamp != nil {
+					continue
+				}
+				eviction := &policyv1.Eviction{
+					ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+				}
+				err := clientset.CoreV1().Pods(pod.Namespace).EvictV1(ctx, eviction)
+				if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+					return nil, "", fmt.Errorf("[ERROR] Error evicting pod %s/%s: %s", pod.Namespace, pod.Name, err)
+				}
+			}
+		}
+		if remaining > 0 {
+			log.Printf("workerpool: %s pods left to drain: %d", workerPoolID, remaining)
+			return nodes, workerPoolDraining, nil
+		}
+		return nodes, workerPoolDrained, nil
+	}
+}
+
+// isEvictablePod reports whether a pod has to be moved off a node before it is removed.
+// Finished pods, static pods and DaemonSet pods are left in place.
+func isEvictablePod(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return true
+}
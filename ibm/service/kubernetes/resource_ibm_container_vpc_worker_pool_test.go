@@ -374,6 +374,40 @@ func TestAccIBMContainerVpcClusterWorkerPoolEnvvar(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerVpcClusterWorkerPoolRotation(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolRotation(name, "bx2.4x16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "flavor", "bx2.4x16"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_pool_name", name),
+				),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolRotation(name, "bx2.8x32"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "flavor", "bx2.8x32"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_pool_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "labels.test", "test-pool"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "taints.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMContainerVpcClusterWorkerPoolKmsAccountEnvvar(t *testing.T) {
 
 	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
@@ -467,6 +501,37 @@ func testAccCheckIBMVpcContainerWorkerPoolEnvvarUpdate(name string) string {
 		`, name, acc.IksClusterVpcID, acc.IksClusterSubnetID, acc.KmsInstanceID, acc.CrkID, acc.WorkerPoolSecondaryStorage)
 }
 
+func testAccCheckIBMVpcContainerWorkerPoolRotation(name, flavor string) string {
+	return fmt.Sprintf(testAccCheckIBMContainerVpcClusterEnvvar(name)+`
+	data "ibm_container_cluster_config" "cluster_config" {
+	  cluster_name_id = ibm_container_vpc_cluster.cluster.id
+	  admin           = true
+	}
+
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "%[2]s"
+	  vpc_id            = "%[3]s"
+	  worker_count      = 1
+	  update_strategy   = "rotation"
+	  kube_config_path  = data.ibm_container_cluster_config.cluster_config.config_file_path
+	  zones {
+		subnet_id = "%[4]s"
+		name      = "us-south-1"
+	  }
+	  labels = {
+		"test" = "test-pool"
+	  }
+	  taints {
+		key    = "key1"
+		value  = "value1"
+		effect = "NoSchedule"
+	  }
+	}
+		`, name, flavor, acc.IksClusterVpcID, acc.IksClusterSubnetID)
+}
+
 func testAccCheckIBMVpcContainerWorkerPoolKmsAccountEnvvar(name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_container_vpc_worker_pool" "test_pool" {
@@ -19,6 +19,7 @@ const (
 	Arg_DhcpID                              = "pi_dhcp_id"
 	Arg_DhcpName                            = "pi_dhcp_name"
 	Arg_DhcpSnatEnabled                     = "pi_dhcp_snat_enabled"
+	Arg_Force                               = "pi_force"
 	Arg_IBMiCSS                             = "pi_ibmi_css"
 	Arg_IBMiPHA                             = "pi_ibmi_pha"
 	Arg_IBMiRDSUsers                        = "pi_ibmi_rds_users"
@@ -34,6 +35,7 @@ const (
 	Arg_PVMInstanceHealthStatus             = "pi_health_status"
 	Arg_PVMInstanceId                       = "pi_instance_id"
 	Arg_ReplicationEnabled                  = "pi_replication_enabled"
+	Arg_RestoreFailAction                   = "pi_restore_fail_action"
 	Arg_SAP                                 = "sap"
 	Arg_SAPProfileID                        = "pi_sap_profile_id"
 	Arg_SharedProcessorPoolHostGroup        = "pi_shared_processor_pool_host_group"
@@ -237,6 +239,7 @@ const (
 	Attr_ReservedCores                               = "reserved_cores"
 	Attr_ResultsOnboardedVolumes                     = "results_onboarded_volumes"
 	Attr_ResultsVolumeOnboardingFailures             = "results_volume_onboarding_failures"
+	Attr_RestoredVolumeIDs                           = "restored_volume_ids"
 	Attr_ServerName                                  = "server_name"
 	Attr_Shareable                                   = "shreable"
 	Attr_SharedCoreRatio                             = "shared_core_ratio"
@@ -328,6 +331,10 @@ const (
 	Affinity     = "affinity"
 	AntiAffinity = "anti-affinity"
 
+	// Snapshot Restore Fail Actions
+	RestoreFailAction_Retry    = "retry"
+	RestoreFailAction_Rollback = "rollback"
+
 	// States
 	State_Active              = "active"
 	State_ACTIVE              = "ACTIVE"
@@ -339,6 +346,7 @@ const (
 	State_Deleted             = "deleted"
 	State_Deleting            = "deleting"
 	State_DELETING            = "DELETING"
+	State_Error               = "error"
 	State_Failed              = "failed"
 	State_Inactive            = "inactive"
 	State_InProgress          = "in progress"
@@ -347,6 +355,7 @@ const (
 	State_PendingReclaimation = "pending_reclamation"
 	State_Provisioning        = "provisioning"
 	State_Removed             = "removed"
+	State_Restoring           = "restoring"
 	State_Retry               = "retry"
 
 	// Health
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+// piInstancePlacement is where the requested instances have to land in the system pool.
+type piInstancePlacement struct {
+	// Policy is the affinity or anti-affinity policy of the placement group or of the replicants.
+	Policy string
+	// Members is the number of other instances in the placement group.
+	Members int
+	// MemberHosts are the hosts of the other instances in the placement group, when they are known.
+	MemberHosts []int64
+	// CurrentHost is the host of an existing instance that is resized, 0 on create.
+	CurrentHost int64
+}
+
+// checkPIInstanceSystemPoolCapacity checks that the requested instances fit on the hosts of
+// the system pool, honoring affinity and anti-affinity placement. A resized instance stays on
+// its host, so only the increase is checked against that host.
+func checkPIInstanceSystemPoolCapacity(pools models.SystemPools, sysType string, procs, mem float64, replicants int, placement piInstancePlacement) error {
+	pool, ok := pools[sysType]
+	if !ok {
+		available := make([]string, 0, len(pools))
+		for name := range pools {
+			available = append(available, name)
+		}
+		sort.Strings(available)
+		return fmt.Errorf("%s %s is not available in this workspace, available system types are: %s", helpers.PIInstanceSystemType, sysType, strings.Join(available, ", "))
+	}
+
+	if placement.CurrentHost != 0 {
+		if host := piSystemByID(pool.Systems, placement.CurrentHost); host != nil {
+			if fitsOnPISystems([]*models.System{host}, procs, mem) == 0 {
+				return fmt.Errorf("the %s host %d of the instance does not have %v more processors and %v GB more memory available", sysType, placement.CurrentHost, procs, mem)
+			}
+			return nil
+		}
+	}
+
+	switch placement.Policy {
+	case Affinity:
+		// every instance has to land on the same host, the host of the other members if there are any
+		systems := pool.Systems
+		if len(placement.MemberHosts) > 0 {
+			if host := piSystemByID(pool.Systems, placement.MemberHosts[0]); host != nil {
+				systems = []*models.System{host}
+			}
+		}
+		if fitsOnPISystems(systems, procs*float64(replicants), mem*float64(replicants)) == 0 {
+			return fmt.Errorf("no %s host has %v processors and %v GB memory available for %d instances with %s placement", sysType, procs*float64(replicants), mem*float64(replicants), replicants, Affinity)
+		}
+	case AntiAffinity:
+		// every instance has to land on a different host than the other members
+		if len(pool.Systems) < replicants+placement.Members {
+			return fmt.Errorf("%s placement needs %d %s hosts but the workspace only has %d", AntiAffinity, replicants+placement.Members, sysType, len(pool.Systems))
+		}
+		systems := piSystemsExcept(pool.Systems, placement.MemberHosts)
+		if fits := fitsOnPISystems(systems, procs, mem); fits < replicants {
+			return fmt.Errorf("%s placement needs %d %s hosts with %v processors and %v GB memory available but only %d have that capacity", AntiAffinity, replicants, sysType, procs, mem, fits)
+		}
+	default:
+		if fitsOnPISystems(pool.Systems, procs, mem) == 0 {
+			maxCores, maxMemory := 0.0, int64(0)
+			if pool.MaxCoresAvailable != nil && pool.MaxCoresAvailable.Cores != nil {
+				maxCores = *pool.MaxCoresAvailable.Cores
+			}
+			if pool.MaxMemoryAvailable != nil && pool.MaxMemoryAvailable.Memory != nil {
+				maxMemory = *pool.MaxMemoryAvailable.Memory
+			}
+			return fmt.Errorf("no %s host has %v processors and %v GB memory available, the largest available is %v processors or %d GB memory", sysType, procs, mem, maxCores, maxMemory)
+		}
+	}
+	return nil
+}
+
+func piSystemByID(systems []*models.System, id int64) *models.System {
+	for _, system := range systems {
+		if system != nil && system.ID == id {
+			return system
+		}
+	}
+	return nil
+}
+
+// piSystemsExcept returns the hosts that are not in hosts.
+func piSystemsExcept(systems []*models.System, hosts []int64) []*models.System {
+	used := make(map[int64]bool, len(hosts))
+	for _, host := range hosts {
+		used[host] = true
+	}
+	result := make([]*models.System, 0, len(systems))
+	for _, system := range systems {
+		if system != nil && !used[system.ID] {
+			result = append(result, system)
+		}
+	}
+	return result
+}
+
+// fitsOnPISystems returns the number of hosts that have the processors and memory available.
+func fitsOnPISystems(systems []*models.System, procs, mem float64) int {
+	var fits int
+	for _, system := range systems {
+		if system == nil || system.Cores == nil {
+			continue
+		}
+		// Cores are the available processor units but Memory is the total memory of the host
+		if *system.Cores >= procs && float64(system.AvailableMemory) >= mem {
+			fits++
+		}
+	}
+	return fits
+}
+
+func checkPISharedProcessorPoolCapacity(pools *models.SharedProcessorPools, name string, procs float64) error {
+	if pools == nil {
+		return nil
+	}
+	for _, spp := range pools.SharedProcessorPools {
+		if spp == nil || spp.Name == nil || (*spp.Name != name && (spp.ID == nil || *spp.ID != name)) {
+			continue
+		}
+		if spp.AvailableCores != nil && *spp.AvailableCores < procs {
+			return fmt.Errorf("shared processor pool %s has %v cores available but %v processors were requested", name, *spp.AvailableCores, procs)
+		}
+		return nil
+	}
+	return fmt.Errorf("shared processor pool %s does not exist in this workspace", name)
+}
+
+func checkPIStoragePoolAvailable(capacity *models.StoragePoolsCapacity, storageType, storagePool string) error {
+	if capacity == nil {
+		return nil
+	}
+	for _, pool := range capacity.StoragePoolsCapacity {
+		if pool == nil {
+			continue
+		}
+		if storagePool != "" && pool.PoolName != storagePool {
+			continue
+		}
+		if storageType != "" && pool.StorageType != storageType {
+			if storagePool != "" {
+				return fmt.Errorf("storage pool %s provides storage type %s, not %s", storagePool, pool.StorageType, storageType)
+			}
+			continue
+		}
+		return nil
+	}
+	if storagePool != "" {
+		return fmt.Errorf("storage pool %s is not available in this workspace", storagePool)
+	}
+	return fmt.Errorf("storage type %s is not available in this workspace", storageType)
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func testPISystem(id int64, cores float64, memory int64) *models.System {
+	return &models.System{ID: id, Cores: &cores, AvailableMemory: memory, Memory: &memory}
+}
+
+func TestCheckPIInstanceSystemPoolCapacity(t *testing.T) {
+	pools := models.SystemPools{
+		"s922": {Systems: []*models.System{
+			testPISystem(1, 4, 64),
+			testPISystem(2, 1, 16),
+			testPISystem(3, 8, 256),
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		sysType    string
+		procs      float64
+		mem        float64
+		replicants int
+		placement  piInstancePlacement
+		err        string
+	}{
+		{name: "fits on any host", sysType: "s922", procs: 8, mem: 128, replicants: 1},
+		{name: "unknown system type", sysType: "e980", procs: 1, mem: 2, replicants: 1,
+			err: "pi_sys_type e980 is not available in this workspace, available system types are: s922"},
+		{name: "too large for every host", sysType: "s922", procs: 16, mem: 2, replicants: 1,
+			err: "no s922 host has 16 processors and 2 GB memory available, the largest available is 0 processors or 0 GB memory"},
+		{name: "affinity fits on one host", sysType: "s922", procs: 4, mem: 64, replicants: 2,
+			placement: piInstancePlacement{Policy: Affinity}},
+		{name: "affinity too large for one host", sysType: "s922", procs: 5, mem: 64, replicants: 2,
+			placement: piInstancePlacement{Policy: Affinity},
+			err:       "no s922 host has 10 processors and 128 GB memory available for 2 instances with affinity placement"},
+		{name: "affinity checked on the host of the members", sysType: "s922", procs: 2, mem: 16, replicants: 1,
+			placement: piInstancePlacement{Policy: Affinity, Members: 1, MemberHosts: []int64{2}},
+			err:       "no s922 host has 2 processors and 16 GB memory available for 1 instances with affinity placement"},
+		{name: "anti-affinity needs a host per instance", sysType: "s922", procs: 1, mem: 8, replicants: 2,
+			placement: piInstancePlacement{Policy: AntiAffinity, Members: 2},
+			err:       "anti-affinity placement needs 4 s922 hosts but the workspace only has 3"},
+		{name: "anti-affinity skips the hosts of the members", sysType: "s922", procs: 2, mem: 32, replicants: 1,
+			placement: piInstancePlacement{Policy: AntiAffinity, Members: 2, MemberHosts: []int64{1, 3}},
+			err:       "anti-affinity placement needs 1 s922 hosts with 2 processors and 32 GB memory available but only 0 have that capacity"},
+		{name: "anti-affinity fits on a free host", sysType: "s922", procs: 2, mem: 32, replicants: 1,
+			placement: piInstancePlacement{Policy: AntiAffinity, Members: 2, MemberHosts: []int64{1, 2}}},
+		{name: "resize in a full anti-affinity group", sysType: "s922", procs: 1, mem: 16, replicants: 1,
+			placement: piInstancePlacement{Policy: AntiAffinity, Members: 2, MemberHosts: []int64{1, 3}, CurrentHost: 2}},
+		{name: "resize checked on the current host", sysType: "s922", procs: 2, mem: 16, replicants: 1,
+			placement: piInstancePlacement{CurrentHost: 2},
+			err:       "the s922 host 2 of the instance does not have 2 more processors and 16 GB more memory available"},
+		{name: "resize on an unknown host is checked on any host", sysType: "s922", procs: 2, mem: 16, replicants: 1,
+			placement: piInstancePlacement{CurrentHost: 9}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPIInstanceSystemPoolCapacity(pools, test.sysType, test.procs, test.mem, test.replicants, test.placement)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestFitsOnPISystems(t *testing.T) {
+	cores, total := 8.0, int64(512)
+	full := &models.System{ID: 4, Cores: &cores, AvailableMemory: 16, Memory: &total}
+	systems := []*models.System{testPISystem(1, 4, 64), nil, {ID: 2}, testPISystem(3, 2, 128), full}
+	tests := []struct {
+		procs, mem float64
+		fits       int
+	}{
+		{procs: 1, mem: 1, fits: 3},
+		{procs: 4, mem: 64, fits: 1},
+		{procs: 2, mem: 100, fits: 1},
+		{procs: 8, mem: 1, fits: 1},
+		// the total memory of host 4 fits but its available memory does not
+		{procs: 8, mem: 256, fits: 0},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.fits, fitsOnPISystems(systems, test.procs, test.mem))
+	}
+}
+
+func TestCheckPISharedProcessorPoolCapacity(t *testing.T) {
+	id, name, available := "spp-id", "spp", 2.0
+	pools := &models.SharedProcessorPools{SharedProcessorPools: []*models.SharedProcessorPool{
+		{ID: &id, Name: &name, AvailableCores: &available},
+	}}
+	tests := []struct {
+		name  string
+		pool  string
+		procs float64
+		err   string
+	}{
+		{name: "by name", pool: "spp", procs: 2},
+		{name: "by id", pool: "spp-id", procs: 1},
+		{name: "not enough cores", pool: "spp", procs: 3, err: "shared processor pool spp has 2 cores available but 3 processors were requested"},
+		{name: "missing pool", pool: "other", procs: 1, err: "shared processor pool other does not exist in this workspace"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPISharedProcessorPoolCapacity(pools, test.pool, test.procs)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestCheckPIStoragePoolAvailable(t *testing.T) {
+	capacity := &models.StoragePoolsCapacity{StoragePoolsCapacity: []*models.StoragePoolCapacity{
+		{PoolName: "Tier1-Flash-1", StorageType: "tier1"},
+		{PoolName: "Tier3-Flash-1", StorageType: "tier3"},
+	}}
+	tests := []struct {
+		name        string
+		storageType string
+		storagePool string
+		err         string
+	}{
+		{name: "type", storageType: "tier3"},
+		{name: "pool", storagePool: "Tier1-Flash-1"},
+		{name: "pool and type", storageType: "tier1", storagePool: "Tier1-Flash-1"},
+		{name: "pool of another type", storageType: "tier3", storagePool: "Tier1-Flash-1",
+			err: "storage pool Tier1-Flash-1 provides storage type tier1, not tier3"},
+		{name: "missing pool", storagePool: "Tier0-Flash-1", err: "storage pool Tier0-Flash-1 is not available in this workspace"},
+		{name: "missing type", storageType: "tier0", err: "storage type tier0 is not available in this workspace"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkPIStoragePoolAvailable(capacity, test.storageType, test.storagePool)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -8,10 +8,12 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"math"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -31,6 +33,12 @@ func ResourceIBMPIInstance() *schema.Resource {
 		DeleteContext: resourceIBMPIInstanceDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMPIInstanceCapacityDiff(ctx, diff, meta)
+			},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -1594,6 +1602,123 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 	return pvmList, nil
 }
 
+// resourceIBMPIInstanceCapacityDiff fails the plan when the requested processors, memory,
+// shared processor pool or storage do not fit the live capacity of the workspace.
+// Capacity lookups that fail are logged and skipped so that the plan is never blocked
+// by the capacity APIs themselves.
+func resourceIBMPIInstanceCapacityDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(helpers.PIInstanceProcessors) && !diff.HasChange(helpers.PIInstanceMemory) {
+		return nil
+	}
+	for _, key := range []string{helpers.PICloudInstanceId, helpers.PIInstanceProcessors, helpers.PIInstanceMemory, helpers.PIInstanceSystemType, helpers.PIInstanceReplicants} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+	if _, ok := diff.GetOk(PISAPInstanceProfileID); ok {
+		// cores and memory come from the SAP profile
+		return nil
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	cloudInstanceID := diff.Get(helpers.PICloudInstanceId).(string)
+
+	procs := diff.Get(helpers.PIInstanceProcessors).(float64)
+	mem := diff.Get(helpers.PIInstanceMemory).(float64)
+	replicants := 1
+	if diff.Id() == "" {
+		replicants = diff.Get(helpers.PIInstanceReplicants).(int)
+	} else {
+		// only the increase has to fit on an existing instance
+		oldProcs, _ := diff.GetChange(helpers.PIInstanceProcessors)
+		oldMem, _ := diff.GetChange(helpers.PIInstanceMemory)
+		procs = math.Max(procs-oldProcs.(float64), 0)
+		mem = math.Max(mem-oldMem.(float64), 0)
+	}
+	if procs == 0 && mem == 0 {
+		return nil
+	}
+
+	placement := piInstancePlacement{Policy: diff.Get(helpers.PIInstanceReplicationPolicy).(string)}
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	var instanceID string
+	if diff.Id() != "" {
+		if parts, err := flex.IdParts(diff.Id()); err == nil && len(parts) > 1 {
+			instanceID = parts[1]
+			if pvm, err := client.Get(instanceID); err != nil {
+				log.Printf("[WARN] checking the resize of %s against any host, its host is unknown: %s", instanceID, err)
+			} else {
+				placement.CurrentHost = pvm.HostID
+			}
+		}
+	}
+	if pgID, ok := diff.GetOk(helpers.PIPlacementGroupID); ok && diff.NewValueKnown(helpers.PIPlacementGroupID) && placement.CurrentHost == 0 {
+		pgClient := st.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
+		pg, err := pgClient.Get(pgID.(string))
+		if err != nil {
+			log.Printf("[WARN] skipping placement group capacity check for %s: %s", pgID, err)
+		} else if pg.Policy != nil {
+			placement.Policy = *pg.Policy
+			for _, member := range pg.Members {
+				// the members include the instance itself once it is created
+				if member == instanceID {
+					continue
+				}
+				placement.Members++
+				if pvm, err := client.Get(member); err != nil {
+					log.Printf("[WARN] the host of placement group member %s is unknown: %s", member, err)
+				} else if pvm.HostID != 0 {
+					placement.MemberHosts = append(placement.MemberHosts, pvm.HostID)
+				}
+			}
+		}
+	}
+
+	if sysType, ok := diff.GetOk(helpers.PIInstanceSystemType); ok {
+		poolClient := st.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID)
+		pools, err := poolClient.GetSystemPools()
+		if err != nil {
+			log.Printf("[WARN] skipping system pool capacity check: %s", err)
+		} else if err := checkPIInstanceSystemPoolCapacity(pools, sysType.(string), procs, mem, replicants, placement); err != nil {
+			return err
+		}
+	}
+
+	if spp, ok := diff.GetOk(Arg_PIInstanceSharedProcessorPool); ok && diff.NewValueKnown(Arg_PIInstanceSharedProcessorPool) {
+		sppClient := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+		pools, err := sppClient.GetAll()
+		if err != nil {
+			log.Printf("[WARN] skipping shared processor pool capacity check: %s", err)
+		} else if err := checkPISharedProcessorPoolCapacity(pools, spp.(string), procs*float64(replicants)); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() == "" {
+		var storageType, storagePool string
+		if diff.NewValueKnown(helpers.PIInstanceStorageType) {
+			storageType = diff.Get(helpers.PIInstanceStorageType).(string)
+		}
+		if diff.NewValueKnown(PIInstanceStoragePool) {
+			storagePool = diff.Get(PIInstanceStoragePool).(string)
+		}
+		if storageType != "" || storagePool != "" {
+			capacityClient := st.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+			capacity, err := capacityClient.GetAllStoragePoolsCapacity()
+			if err != nil {
+				log.Printf("[WARN] skipping storage capacity check: %s", err)
+			} else if err := checkPIStoragePoolAvailable(capacity, storageType, storagePool); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func splitID(id string) (id1, id2 string, err error) {
 	parts, err := flex.IdParts(id)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -727,3 +728,18 @@ func TestAccIBMPIInstanceDeploymentTypeNoStorage(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMPIInstanceCapacityExceeded(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIActiveInstanceConfigUpdate(name, helpers.PIInstanceHealthOk, "1000", "100000"),
+				ExpectError: regexp.MustCompile("no s922 host has 1000 processors and 100000 GB memory available"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_snapshots"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPISnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISnapshotRestoreCreate,
+		ReadContext:   resourceIBMPISnapshotRestoreRead,
+		DeleteContext: resourceIBMPISnapshotRestoreDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Force: {
+				Default:     false,
+				Description: "By default the PVM instance must be shut off during a snapshot restore. Set to true to relax the shut off pre-condition.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_PVMInstanceHealthStatus: {
+				Default:      PVMInstanceHealthOk,
+				Description:  "Specifies if Terraform should poll for the health status to be OK or WARNING after the restore.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{PVMInstanceHealthOk, PVMInstanceHealthWarning}),
+			},
+			Arg_PVMInstanceId: {
+				Description:  "The ID of the PVM instance to restore.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_RestoreFailAction: {
+				Default:      RestoreFailAction_Retry,
+				Description:  "Action to take on a failed snapshot restore. Allowed values are retry and rollback.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{RestoreFailAction_Retry, RestoreFailAction_Rollback}),
+			},
+			Arg_SnapshotID: {
+				Description:  "The ID of the PVM instance snapshot to restore.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_HealthStatus: {
+				Computed:    true,
+				Description: "The health status of the PVM instance after the restore.",
+				Type:        schema.TypeString,
+			},
+			Attr_RestoredVolumeIDs: {
+				Computed:    true,
+				Description: "The IDs of the volumes restored from the snapshot.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the PVM instance snapshot.",
+				Type:        schema.TypeString,
+			},
+			Attr_VolumeSnapshots: {
+				Computed:    true,
+				Description: "A map of volume snapshots restored to the PVM instance.",
+				Type:        schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceIBMPISnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_PVMInstanceId).(string)
+	snapshotID := d.Get(Arg_SnapshotID).(string)
+	force := d.Get(Arg_Force).(bool)
+	restoreFailAction := d.Get(Arg_RestoreFailAction).(string)
+	healthStatus := d.Get(Arg_PVMInstanceHealthStatus).(string)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	body := &models.SnapshotRestore{Force: &force}
+
+	log.Printf("[DEBUG] restoring PVM instance %s to snapshot %s", instanceID, snapshotID)
+	_, err = client.RestoreSnapShotVM(instanceID, snapshotID, restoreFailAction, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, instanceID, snapshotID))
+
+	snapshotClient := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	_, err = isWaitForPIInstanceSnapshotRestored(ctx, snapshotClient, snapshotID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, healthStatus)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPISnapshotRestoreRead(ctx, d, meta)
+}
+
+func resourceIBMPISnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.Errorf("invalid ID %s: expected <cloud_instance_id>/<instance_id>/<snapshot_id>", d.Id())
+	}
+	cloudInstanceID, instanceID, snapshotID := parts[0], parts[1], parts[2]
+
+	snapshotClient := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	snapshot, err := snapshotClient.Get(snapshotID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_snapshots.PcloudCloudinstancesSnapshotsGetNotFound:
+			log.Printf("[DEBUG] snapshot does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_PVMInstanceId, instanceID)
+	d.Set(Arg_SnapshotID, snapshotID)
+	d.Set(Attr_Status, snapshot.Status)
+	d.Set(Attr_VolumeSnapshots, snapshot.VolumeSnapshots)
+
+	volumeIDs := make([]string, 0, len(snapshot.VolumeSnapshots))
+	for volumeID := range snapshot.VolumeSnapshots {
+		volumeIDs = append(volumeIDs, volumeID)
+	}
+	sort.Strings(volumeIDs)
+	d.Set(Attr_RestoredVolumeIDs, volumeIDs)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvm, err := client.Get(instanceID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound:
+			log.Printf("[DEBUG] instance does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if pvm.Health != nil {
+		d.Set(Attr_HealthStatus, pvm.Health.Status)
+	}
+
+	return nil
+}
+
+func resourceIBMPISnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no delete or unset concept for a snapshot restore
+	d.SetId("")
+	return nil
+}
+
+func isWaitForPIInstanceSnapshotRestored(ctx context.Context, client *instance.IBMPISnapshotClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for PIInstance Snapshot (%s) to be restored", id)
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress, State_Restoring},
+		Target:     []string{State_Available},
+		Refresh:    isPIInstanceSnapshotRestoreRefreshFunc(client, id),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isPIInstanceSnapshotRestoreRefreshFunc(client *instance.IBMPISnapshotClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch snapshot.Status {
+		case State_Available:
+			return snapshot, State_Available, nil
+		case State_Error:
+			return snapshot, snapshot.Status, fmt.Errorf("failed to restore the snapshot %s", id)
+		}
+		return snapshot, State_Restoring, nil
+	}
+}
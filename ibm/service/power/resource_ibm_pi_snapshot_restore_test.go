@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPISnapshotRestoreBasic(t *testing.T) {
+	snapshotRestoreRes := "ibm_pi_snapshot_restore.example"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISnapshotRestoreConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(snapshotRestoreRes, "id"),
+					resource.TestCheckResourceAttr(snapshotRestoreRes, "status", "available"),
+					resource.TestCheckResourceAttrSet(snapshotRestoreRes, "restored_volume_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISnapshotRestoreConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_snapshot_restore" "example" {
+		pi_cloud_instance_id	= "%s"
+		pi_instance_id			= "%s"
+		pi_snapshot_id			= "%s"
+		pi_force				= true
+		pi_restore_fail_action	= "rollback"
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, acc.Pi_snapshot_id)
+}
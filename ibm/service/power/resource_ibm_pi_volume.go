@@ -17,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,6 +31,12 @@ func ResourceIBMPIVolume() *schema.Resource {
 		DeleteContext: resourceIBMPIVolumeDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMPIVolumeCapacityDiff(ctx, diff, meta)
+			},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -410,6 +417,85 @@ func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// resourceIBMPIVolumeCapacityDiff fails the plan when the requested volume size does not fit
+// the largest allocation available for the requested pool or storage tier.
+func resourceIBMPIVolumeCapacityDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange(Arg_VolumeSize) {
+		return nil
+	}
+	if !diff.NewValueKnown(Arg_CloudInstanceID) || !diff.NewValueKnown(Arg_VolumeSize) {
+		return nil
+	}
+
+	size := diff.Get(Arg_VolumeSize).(float64)
+	if diff.Id() != "" {
+		// only the increase has to be allocated when resizing
+		oldSize, _ := diff.GetChange(Arg_VolumeSize)
+		size = size - oldSize.(float64)
+		if size <= 0 {
+			return nil
+		}
+	}
+
+	var volumeType, volumePool string
+	if diff.NewValueKnown(Arg_VolumeType) {
+		volumeType = diff.Get(Arg_VolumeType).(string)
+	}
+	if diff.NewValueKnown(Arg_VolumePool) {
+		volumePool = diff.Get(Arg_VolumePool).(string)
+	}
+
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	client := instance.NewIBMPIStorageCapacityClient(ctx, sess, diff.Get(Arg_CloudInstanceID).(string))
+
+	var maxAllocation *models.MaximumStorageAllocation
+	switch {
+	case volumePool != "":
+		pool, err := client.GetStoragePoolCapacity(volumePool)
+		if err != nil {
+			log.Printf("[WARN] skipping storage capacity check for pool %s: %s", volumePool, err)
+			return nil
+		}
+		if volumeType != "" && pool.StorageType != "" && pool.StorageType != volumeType {
+			return fmt.Errorf("%s %s provides storage type %s, not %s", Arg_VolumePool, volumePool, pool.StorageType, volumeType)
+		}
+		if pool.MaxAllocationSize != nil && float64(*pool.MaxAllocationSize) < size {
+			return fmt.Errorf("%s %s can allocate at most %d GB but %v GB were requested", Arg_VolumePool, volumePool, *pool.MaxAllocationSize, size)
+		}
+		return nil
+	case volumeType != "":
+		capacity, err := client.GetStorageTypeCapacity(volumeType)
+		if err != nil {
+			log.Printf("[WARN] skipping storage capacity check for type %s: %s", volumeType, err)
+			return nil
+		}
+		maxAllocation = capacity.MaximumStorageAllocation
+	default:
+		capacity, err := client.GetAllStoragePoolsCapacity()
+		if err != nil {
+			log.Printf("[WARN] skipping storage capacity check: %s", err)
+			return nil
+		}
+		maxAllocation = capacity.MaximumStorageAllocation
+	}
+
+	if maxAllocation != nil && maxAllocation.MaxAllocationSize != nil && float64(*maxAllocation.MaxAllocationSize) < size {
+		var pool, tier string
+		if maxAllocation.StoragePool != nil {
+			pool = *maxAllocation.StoragePool
+		}
+		if maxAllocation.StorageType != nil {
+			tier = *maxAllocation.StorageType
+		}
+		return fmt.Errorf("%v GB were requested but the largest allocation available is %d GB (storage pool %s, storage type %s)", size, *maxAllocation.MaxAllocationSize, pool, tier)
+	}
+
+	return nil
+}
+
 func isWaitForIBMPIVolumeAvailable(ctx context.Context, client *instance.IBMPIVolumeClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for Volume (%s) to be available.", id)
 
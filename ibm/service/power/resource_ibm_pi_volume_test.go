@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -157,6 +158,32 @@ func testAccCheckIBMPIVolumePoolConfig(name string) string {
 		}`, name, acc.Pi_cloud_instance_id, acc.PiStoragePool)
 }
 
+func TestAccIBMPIVolumeCapacityExceeded(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIVolumeCapacityConfig(name),
+				ExpectError: regexp.MustCompile("pi_volume_pool .* can allocate at most"),
+				PlanOnly:    true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeCapacityConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_volume" "power_volume" {
+			pi_cloud_instance_id	= "%[2]s"
+			pi_volume_name       	= "%[1]s"
+			pi_volume_pool       	= "%[3]s"
+			pi_volume_shareable		= true
+			pi_volume_size       	= 100000000
+		}`, name, acc.Pi_cloud_instance_id, acc.PiStoragePool)
+}
+
 // TestAccIBMPIVolumeGRS test the volume replication feature which is part of global replication service(GRS)
 func TestAccIBMPIVolumeGRS(t *testing.T) {
 	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
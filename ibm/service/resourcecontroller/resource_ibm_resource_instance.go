@@ -53,6 +53,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			resourceInstanceParametersCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -114,6 +115,13 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "Arbitrary parameters to pass in Json string format",
 			},
 
+			"deprecated_parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The parameters that are set and deprecated by the plan",
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -557,10 +565,11 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("status", instance.State)
 	d.Set("resource_group_id", instance.ResourceGroupID)
 	if instance.CRN != nil {
-		location := strings.Split(*instance.CRN, ":")
-		if len(location) > 5 {
-			d.Set("location", location[5])
+		crn, err := flex.Parse(*instance.CRN)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing the CRN %s: %s", *instance.CRN, err)
 		}
+		d.Set("location", crn.Region)
 	}
 	d.Set("crn", instance.CRN)
 	d.Set("dashboard_url", instance.DashboardURL)
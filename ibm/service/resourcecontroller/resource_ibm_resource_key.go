@@ -4,6 +4,7 @@
 package resourcecontroller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,7 +13,9 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -31,9 +34,16 @@ func ResourceIBMResourceKey() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMResourceKeyRotationCustomizeDiff(diff, time.Now())
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -189,6 +199,151 @@ func ResourceIBMResourceKey() *schema.Resource {
 				Computed:    true,
 				Description: "The subject who deleted the key.",
 			},
+
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Rotates the key at an interval, keeping the previous key for an overlap period",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The lifetime of a key, in units",
+						},
+						"overlap": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The period before the end of the interval when the new key is created and both keys are valid, in units",
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      resourceKeyRotationUnitDay,
+							ValidateFunc: validation.StringInSlice([]string{resourceKeyRotationUnitDay, resourceKeyRotationUnitHour}, false),
+							Description:  "The unit of interval and overlap, day or hour",
+						},
+					},
+				},
+			},
+
+			"current": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The current key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the key",
+						},
+						"credentials_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The credentials of the key in json string",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the key was created",
+						},
+						"rotate_after": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date after which the next apply rotates the key",
+						},
+					},
+				},
+			},
+
+			"previous": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The previous key during the overlap period of a rotation",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the key",
+						},
+						"credentials_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The credentials of the key in json string",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the key was created",
+						},
+						"delete_after": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date after which the next apply deletes the key",
+						},
+					},
+				},
+			},
+
+			"secrets_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Publishes the current and previous credentials to a key-value secret in Secrets Manager",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Secrets Manager instance",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region of the Secrets Manager instance, defaults to the region of the provider",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+							Description:  "public or private",
+						},
+						"secret_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the secret group of the secret, defaults to the default secret group",
+						},
+						"secret_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the secret, defaults to the name of the key",
+						},
+					},
+				},
+			},
+
+			"secrets_manager_secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret the credentials are published to",
+			},
 		},
 	}
 }
@@ -209,10 +364,26 @@ func ResourceIBMResourceKeyValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	resourceKey, err := createResourceKey(d, meta)
 	if err != nil {
 		return err
 	}
+
+	d.SetId(*resourceKey.ID)
+
+	err = resourceIBMResourceKeyRead(d, meta)
+	if err != nil {
+		return err
+	}
+	return publishResourceKeyCredentials(d, meta)
+}
+
+// createResourceKey creates a key from the configuration, for a new resource or the rotation of its key
+func createResourceKey(d *schema.ResourceData, meta interface{}) (*rc.ResourceKey, error) {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
 	name := d.Get("name").(string)
 
 	var instanceID, aliasID string
@@ -225,7 +396,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if instanceID == "" && aliasID == "" {
-		return fmt.Errorf("[ERROR] Provide either `resource_instance_id` or `resource_alias_id`")
+		return nil, fmt.Errorf("[ERROR] Provide either `resource_instance_id` or `resource_alias_id`")
 	}
 
 	keyParameters := rc.ResourceKeyPostParameters{}
@@ -244,19 +415,19 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err)
+		return nil, fmt.Errorf("[ERROR] Error creating resource key when get instance and CRN: %s", err)
 	}
 
 	serviceID := resourceInstance.ResourceID
 
 	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get ResourceCatalogAPI: %s", err)
+		return nil, fmt.Errorf("[ERROR] Error creating resource key when get ResourceCatalogAPI: %s", err)
 	}
 
 	service, err := rsCatClient.ResourceCatalog().Get(*serviceID, true)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err)
+		return nil, fmt.Errorf("[ERROR] Error creating resource key when get service: %s", err)
 	}
 
 	resourceKeyCreate := rc.CreateResourceKeyOptions{
@@ -268,7 +439,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		role := r.(string)
 		serviceRole, err := getRoleFromName(role, service.Name, meta)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err)
+			return nil, fmt.Errorf("[ERROR] Error creating resource key when get role: %s", err)
 		}
 		keyParameters.SetProperty("role_crn", serviceRole.RoleID)
 		resourceKeyCreate.Role = serviceRole.RoleID
@@ -276,15 +447,25 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
+		return nil, fmt.Errorf("[ERROR] Error creating resource key: %s with resp code: %s", err, resp)
 	}
 
-	d.SetId(*resourceKey.ID)
-
-	return resourceIBMResourceKeyRead(d, meta)
+	return resourceKey, nil
 }
 
 func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	rotated, err := resourceIBMResourceKeyRotate(d, meta)
+	if err != nil {
+		return err
+	}
+
+	err = resourceIBMResourceKeyRead(d, meta)
+	if err != nil {
+		return err
+	}
+	if rotated || d.HasChange("secrets_manager") {
+		return publishResourceKeyCredentials(d, meta)
+	}
 	return nil
 }
 
@@ -384,7 +565,7 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("updated_by", *resourceKey.UpdatedBy)
 	d.Set("deleted_by", *resourceKey.DeletedBy)
 
-	return nil
+	return readResourceKeyRotation(d, meta, resourceKey)
 }
 
 func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -403,6 +584,17 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("[ERROR] Error deleting resource key: %s with resp code: %s", err, resp)
 	}
 
+	if previous := d.Get("previous").([]interface{}); len(previous) > 0 && previous[0] != nil {
+		err = deleteResourceKey(previous[0].(map[string]interface{})["id"].(string), meta)
+		if err != nil {
+			return err
+		}
+	}
+	err = deleteResourceKeySecret(meta, d.Get("secrets_manager").([]interface{}), d.Get("secrets_manager_secret_id").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error deleting the secret of resource key %s: %s", d.Id(), err)
+	}
+
 	d.SetId("")
 
 	return nil
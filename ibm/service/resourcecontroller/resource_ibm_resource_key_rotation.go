@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+)
+
+const (
+	resourceKeyRotationUnitDay  = "day"
+	resourceKeyRotationUnitHour = "hour"
+)
+
+// resourceKeyRotationPeriods returns the rotation interval and overlap of a rotation block
+func resourceKeyRotationPeriods(rotation []interface{}) (interval, overlap time.Duration, ok bool) {
+	if len(rotation) == 0 || rotation[0] == nil {
+		return 0, 0, false
+	}
+	r := rotation[0].(map[string]interface{})
+	unit := 24 * time.Hour
+	if r["unit"] == resourceKeyRotationUnitHour {
+		unit = time.Hour
+	}
+	return time.Duration(r["interval"].(int)) * unit, time.Duration(r["overlap"].(int)) * unit, true
+}
+
+// resourceKeyRotationPlan decides whether the current key is rotated and whether the previous key is deleted. A key is
+// rotated an overlap before its interval ends, and the previous key is kept until then. A rotation waits for the
+// previous key to be deleted so that at most two keys exist
+func resourceKeyRotationPlan(rotation []interface{}, createdAt string, previous []interface{}, now time.Time) (rotate bool, deletePrevious bool) {
+	if len(previous) > 0 && previous[0] != nil {
+		deleteAfter, err := time.Parse(time.RFC3339, previous[0].(map[string]interface{})["delete_after"].(string))
+		deletePrevious = err == nil && !now.Before(deleteAfter)
+	}
+	interval, overlap, ok := resourceKeyRotationPeriods(rotation)
+	if !ok {
+		return false, deletePrevious
+	}
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, deletePrevious
+	}
+	rotate = !now.Before(created.Add(interval-overlap)) && (len(previous) == 0 || deletePrevious)
+	return rotate, deletePrevious
+}
+
+func resourceIBMResourceKeyRotationCustomizeDiff(diff *schema.ResourceDiff, now time.Time) error {
+	if interval, overlap, ok := resourceKeyRotationPeriods(diff.Get("rotation").([]interface{})); ok && overlap >= interval {
+		return fmt.Errorf("[ERROR] The rotation overlap must be shorter than the interval")
+	}
+	if diff.Id() == "" {
+		return nil
+	}
+	previous, _ := diff.GetChange("previous")
+	createdAt, _ := diff.GetChange("created_at")
+	rotate, deletePrevious := resourceKeyRotationPlan(diff.Get("rotation").([]interface{}), createdAt.(string), previous.([]interface{}), now)
+	if deletePrevious {
+		if err := diff.SetNewComputed("previous"); err != nil {
+			return err
+		}
+	}
+	if rotate {
+		for _, key := range []string{"current", "previous", "credentials", "credentials_json", "crn", "guid", "url", "created_at", "updated_at", "created_by", "updated_by"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resourceIBMResourceKeyRotate deletes the previous key when its overlap window ended and replaces the current key when
+// it is due for rotation. Only the changes that were planned are applied, the plan marks crn unknown for a rotation and
+// previous unknown for a deletion
+func resourceIBMResourceKeyRotate(d *schema.ResourceData, meta interface{}) (bool, error) {
+	oldPrevious, _ := d.GetChange("previous")
+	oldCreatedAt, _ := d.GetChange("created_at")
+	previous := oldPrevious.([]interface{})
+	rotate, deletePrevious := resourceKeyRotationPlan(d.Get("rotation").([]interface{}), oldCreatedAt.(string), previous, time.Now())
+	rotate = rotate && d.HasChange("crn")
+	deletePrevious = deletePrevious && d.HasChange("previous")
+	changed := false
+
+	if deletePrevious {
+		previousID := previous[0].(map[string]interface{})["id"].(string)
+		if err := deleteResourceKey(previousID, meta); err != nil {
+			return changed, err
+		}
+		previous = []interface{}{}
+		changed = true
+	}
+
+	if rotate {
+		resourceKey, err := createResourceKey(d, meta)
+		if err != nil {
+			return changed, fmt.Errorf("[ERROR] Error rotating resource key (%s): %s", d.Id(), err)
+		}
+		interval, _, _ := resourceKeyRotationPeriods(d.Get("rotation").([]interface{}))
+		created, _ := time.Parse(time.RFC3339, oldCreatedAt.(string))
+		oldCrn, _ := d.GetChange("crn")
+		oldCredentials, _ := d.GetChange("credentials_json")
+		previous = []interface{}{
+			map[string]interface{}{
+				"id":               d.Id(),
+				"crn":              oldCrn,
+				"credentials_json": oldCredentials,
+				"created_at":       oldCreatedAt,
+				"delete_after":     created.Add(interval).UTC().Format(time.RFC3339),
+			},
+		}
+		d.SetId(*resourceKey.ID)
+		changed = true
+	}
+
+	if changed {
+		if err := d.Set("previous", previous); err != nil {
+			return changed, fmt.Errorf("[ERROR] Error setting previous: %s", err)
+		}
+	}
+	return changed, nil
+}
+
+// readResourceKeyRotation sets current and refreshes the credentials of the previous key
+func readResourceKeyRotation(d *schema.ResourceData, meta interface{}, resourceKey *rc.ResourceKey) error {
+	current := map[string]interface{}{
+		"id":               d.Id(),
+		"crn":              d.Get("crn"),
+		"credentials_json": d.Get("credentials_json"),
+		"created_at":       d.Get("created_at"),
+	}
+	if interval, overlap, ok := resourceKeyRotationPeriods(d.Get("rotation").([]interface{})); ok && resourceKey.CreatedAt != nil {
+		current["rotate_after"] = time.Time(*resourceKey.CreatedAt).Add(interval - overlap).UTC().Format(time.RFC3339)
+	}
+	if err := d.Set("current", []interface{}{current}); err != nil {
+		return fmt.Errorf("[ERROR] Error setting current: %s", err)
+	}
+
+	previous := d.Get("previous").([]interface{})
+	if len(previous) == 0 || previous[0] == nil {
+		return nil
+	}
+	p := previous[0].(map[string]interface{})
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	previousID := p["id"].(string)
+	previousKey, resp, err := rsContClient.GetResourceKey(&rc.GetResourceKeyOptions{ID: &previousID})
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			log.Printf("[WARN] The previous resource key %s no longer exists", previousID)
+			return d.Set("previous", []interface{}{})
+		}
+		return fmt.Errorf("[ERROR] Error retrieving previous resource key (%s): %s with resp : %s", previousID, err, resp)
+	}
+	if previousKey.State != nil && *previousKey.State == "removed" {
+		return d.Set("previous", []interface{}{})
+	}
+	creds, err := json.Marshal(previousKey.Credentials)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error marshalling previous resource key credentials: %s", err)
+	}
+	p["credentials_json"] = string(creds)
+	return d.Set("previous", []interface{}{p})
+}
+
+func deleteResourceKey(resourceKeyID string, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	resp, err := rsContClient.DeleteResourceKey(&rc.DeleteResourceKeyOptions{ID: &resourceKeyID})
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting resource key (%s): %s with resp code: %s", resourceKeyID, err, resp)
+	}
+	return nil
+}
+
+// resourceKeySecretsManagerClient returns the client of the Secrets Manager instance of a secrets_manager block
+func resourceKeySecretsManagerClient(meta interface{}, block map[string]interface{}) (*secretsmanagerv2.SecretsManagerV2, error) {
+	client, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, err
+	}
+	return secretsmanager.GetClientWithInstanceEndpoint(client, block["instance_id"].(string), block["region"].(string), block["endpoint_type"].(string)), nil
+}
+
+// publishResourceKeyCredentials publishes the current and previous credentials to a key-value secret, as a new version
+// of the secret when it already exists
+func publishResourceKeyCredentials(d *schema.ResourceData, meta interface{}) error {
+	oldBlock, newBlock := d.GetChange("secrets_manager")
+	secretID := d.Get("secrets_manager_secret_id").(string)
+	if secretID != "" && resourceKeySecretsManagerLocationChanged(oldBlock.([]interface{}), newBlock.([]interface{})) {
+		if err := deleteResourceKeySecret(meta, oldBlock.([]interface{}), secretID); err != nil {
+			log.Printf("[WARN] Error deleting the previous secret %s of resource key %s: %s", secretID, d.Id(), err)
+		}
+		secretID = ""
+	}
+	blocks := newBlock.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		if secretID != d.Get("secrets_manager_secret_id").(string) {
+			return d.Set("secrets_manager_secret_id", secretID)
+		}
+		return nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	data := map[string]interface{}{}
+	var current interface{}
+	if err := json.Unmarshal([]byte(d.Get("credentials_json").(string)), &current); err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the resource key credentials: %s", err)
+	}
+	data["current"] = current
+	data["current_resource_key_id"] = d.Id()
+	if previous := d.Get("previous").([]interface{}); len(previous) > 0 && previous[0] != nil {
+		p := previous[0].(map[string]interface{})
+		var credentials interface{}
+		if err := json.Unmarshal([]byte(p["credentials_json"].(string)), &credentials); err == nil {
+			data["previous"] = credentials
+			data["previous_resource_key_id"] = p["id"]
+		}
+	}
+
+	client, err := resourceKeySecretsManagerClient(meta, block)
+	if err != nil {
+		return err
+	}
+	if secretID != "" {
+		createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+		createSecretVersionOptions.SetSecretID(secretID)
+		createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.KVSecretVersionPrototype{Data: data})
+		_, response, err := client.CreateSecretVersionWithContext(context.Background(), createSecretVersionOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error publishing the credentials of resource key %s to secret %s: %s\n%s", d.Id(), secretID, err, response)
+		}
+		return nil
+	}
+
+	name := block["secret_name"].(string)
+	if name == "" {
+		name = d.Get("name").(string)
+	}
+	prototype := &secretsmanagerv2.KVSecretPrototype{
+		SecretType:  core.StringPtr(secretsmanager.KvSecretType),
+		Name:        &name,
+		Description: core.StringPtr(fmt.Sprintf("Credentials of resource key %s", d.Get("name").(string))),
+		Data:        data,
+	}
+	if group := block["secret_group_id"].(string); group != "" {
+		prototype.SecretGroupID = &group
+	}
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	createSecretOptions.SetSecretPrototype(prototype)
+	secretIntf, response, err := client.CreateSecretWithContext(context.Background(), createSecretOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error publishing the credentials of resource key %s: %s\n%s", d.Id(), err, response)
+	}
+	secret := secretIntf.(*secretsmanagerv2.KVSecret)
+	return d.Set("secrets_manager_secret_id", *secret.ID)
+}
+
+// resourceKeySecretsManagerLocationChanged checks whether the secret moves to another instance, group or name, which
+// needs a new secret
+func resourceKeySecretsManagerLocationChanged(oldBlocks, newBlocks []interface{}) bool {
+	if len(oldBlocks) == 0 || oldBlocks[0] == nil || len(newBlocks) == 0 || newBlocks[0] == nil {
+		return len(oldBlocks) != len(newBlocks)
+	}
+	o, n := oldBlocks[0].(map[string]interface{}), newBlocks[0].(map[string]interface{})
+	for _, key := range []string{"instance_id", "region", "secret_group_id", "secret_name"} {
+		if o[key] != n[key] {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteResourceKeySecret(meta interface{}, blocks []interface{}, secretID string) error {
+	if len(blocks) == 0 || blocks[0] == nil || secretID == "" {
+		return nil
+	}
+	client, err := resourceKeySecretsManagerClient(meta, blocks[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	deleteSecretOptions := &secretsmanagerv2.DeleteSecretOptions{}
+	deleteSecretOptions.SetID(secretID)
+	response, err := client.DeleteSecretWithContext(context.Background(), deleteSecretOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("%s\n%s", err, response)
+	}
+	return nil
+}
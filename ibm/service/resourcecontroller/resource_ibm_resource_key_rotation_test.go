@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceKeyRotationPlan(t *testing.T) {
+	rotation := []interface{}{map[string]interface{}{"interval": 30, "overlap": 7, "unit": "day"}}
+	createdAt := "2024-03-01T00:00:00Z"
+	created, _ := time.Parse(time.RFC3339, createdAt)
+
+	rotate, deletePrevious := resourceKeyRotationPlan(nil, createdAt, nil, created.AddDate(1, 0, 0))
+	assert.False(t, rotate)
+	assert.False(t, deletePrevious)
+
+	rotate, _ = resourceKeyRotationPlan(rotation, createdAt, nil, created.AddDate(0, 0, 22))
+	assert.False(t, rotate)
+	rotate, _ = resourceKeyRotationPlan(rotation, createdAt, nil, created.AddDate(0, 0, 23))
+	assert.True(t, rotate)
+
+	previous := []interface{}{map[string]interface{}{"id": "key", "delete_after": "2024-03-10T00:00:00Z"}}
+	rotate, deletePrevious = resourceKeyRotationPlan(rotation, createdAt, previous, created.AddDate(0, 0, 5))
+	assert.False(t, rotate)
+	assert.False(t, deletePrevious)
+	rotate, deletePrevious = resourceKeyRotationPlan(rotation, createdAt, previous, created.AddDate(0, 0, 9))
+	assert.False(t, rotate)
+	assert.True(t, deletePrevious)
+
+	// A rotation waits for the previous key to be deleted
+	previous = []interface{}{map[string]interface{}{"id": "key", "delete_after": "2024-04-10T00:00:00Z"}}
+	rotate, deletePrevious = resourceKeyRotationPlan(rotation, createdAt, previous, created.AddDate(0, 0, 25))
+	assert.False(t, rotate)
+	assert.False(t, deletePrevious)
+	rotate, deletePrevious = resourceKeyRotationPlan(rotation, createdAt, previous, created.AddDate(0, 0, 40))
+	assert.True(t, rotate)
+	assert.True(t, deletePrevious)
+
+	hours := []interface{}{map[string]interface{}{"interval": 2, "overlap": 1, "unit": "hour"}}
+	rotate, _ = resourceKeyRotationPlan(hours, createdAt, nil, created.Add(time.Hour))
+	assert.True(t, rotate)
+}
+
+func TestResourceKeySecretsManagerLocationChanged(t *testing.T) {
+	block := func(group string) []interface{} {
+		return []interface{}{map[string]interface{}{"instance_id": "instance", "region": "", "endpoint_type": "public", "secret_group_id": group, "secret_name": ""}}
+	}
+	assert.False(t, resourceKeySecretsManagerLocationChanged(block("group"), block("group")))
+	assert.True(t, resourceKeySecretsManagerLocationChanged(block("group"), block("other")))
+	assert.True(t, resourceKeySecretsManagerLocationChanged(block("group"), []interface{}{}))
+	assert.False(t, resourceKeySecretsManagerLocationChanged([]interface{}{}, []interface{}{}))
+}
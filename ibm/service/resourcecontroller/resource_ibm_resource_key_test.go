@@ -122,6 +122,30 @@ func TestAccIBMResourceKey_WithCustomRole(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKey_Rotation(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyRotation(resourceName, resourceKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "rotation.0.interval", "30"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "current.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_resource_key.resourceKey", "current.0.id", "ibm_resource_key.resourceKey", "id"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "current.0.rotate_after"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "previous.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMResourceKeyExists(n string) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
@@ -273,3 +297,23 @@ func testAccCheckIBMResourceKeyParameters(resourceName, resourceKey string) stri
 		}
 	`, resourceName, resourceKey)
 }
+
+func testAccCheckIBMResourceKeyRotation(resourceName, resourceKey string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role = "Reader"
+			rotation {
+				interval = 30
+				overlap  = 7
+			}
+		}
+	`, resourceName, resourceKey)
+}
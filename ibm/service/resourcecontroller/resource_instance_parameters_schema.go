@@ -0,0 +1,466 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// resourceInstanceParametersSchemas caches the parameter schemas of the plans for the run, keyed by service and plan. A
+// nil schema is cached for plans without a schema so that the catalog is only queried once per plan
+var resourceInstanceParametersSchemas = struct {
+	sync.Mutex
+	schemas map[string]*resourceInstanceParametersPlanSchemas
+}{schemas: map[string]*resourceInstanceParametersPlanSchemas{}}
+
+type resourceInstanceParametersPlanSchemas struct {
+	Create map[string]interface{}
+	Update map[string]interface{}
+}
+
+// catalogPlanGetter is implemented by the client behind ResourceCatalogAPI, which returns the raw catalog entries
+type catalogPlanGetter interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+// resourceInstanceParametersCustomizeDiff validates parameters and parameters_json against the parameter schema of the
+// plan in Global Catalog, and sets deprecated_parameters to the deprecated parameters in use
+func resourceInstanceParametersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("parameters") && !diff.HasChange("parameters_json") && !diff.HasChange("plan") {
+		return nil
+	}
+	for _, key := range []string{"service", "plan", "parameters", "parameters_json"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	params, err := resourceInstanceParametersFromConfig(diff.Get("parameters").(map[string]interface{}), diff.Get("parameters_json").(string))
+	if err != nil {
+		return err
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	service := diff.Get("service").(string)
+	plan := diff.Get("plan").(string)
+	planSchemas, err := getResourceInstanceParametersSchemas(meta, service, plan)
+	if err != nil {
+		// The broker still validates the parameters, so a catalog failure must not block the plan
+		log.Printf("[WARN] Skipping validation of the parameters of %s plan %s: %s", service, plan, err)
+		return nil
+	}
+	if planSchemas == nil {
+		return nil
+	}
+	paramsSchema := planSchemas.Create
+	if diff.Id() != "" && planSchemas.Update != nil {
+		paramsSchema = planSchemas.Update
+	}
+	if paramsSchema == nil {
+		return nil
+	}
+
+	errs, deprecated := validateResourceInstanceParameters(paramsSchema, params)
+	if len(errs) > 0 {
+		return fmt.Errorf("[ERROR] Invalid parameters for %s plan %s:\n  %s", service, plan, strings.Join(errs, "\n  "))
+	}
+	if len(deprecated) > 0 {
+		log.Printf("[WARN] The parameters %s of %s plan %s are deprecated", strings.Join(deprecated, ", "), service, plan)
+	}
+	old, _ := diff.GetChange("deprecated_parameters")
+	if !resourceInstanceStringListEqual(old.([]interface{}), deprecated) {
+		return diff.SetNew("deprecated_parameters", deprecated)
+	}
+	return nil
+}
+
+func resourceInstanceStringListEqual(list []interface{}, strs []string) bool {
+	if len(list) != len(strs) {
+		return false
+	}
+	for i, s := range strs {
+		if v, _ := list[i].(string); v != s {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceInstanceParametersFromConfig converts the parameters map the way they are sent to resource controller, where
+// the map values true, false and [a,b] are a boolean and a list
+func resourceInstanceParametersFromConfig(parameters map[string]interface{}, parametersJSON string) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
+	for k, v := range parameters {
+		s := v.(string)
+		if s == "true" || s == "false" {
+			b, _ := strconv.ParseBool(s)
+			params[k] = b
+		} else if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+			result := []interface{}{}
+			trimmed := strings.TrimRight(strings.TrimLeft(s, "["), "]")
+			if len(trimmed) > 0 {
+				for _, a := range strings.Split(trimmed, ",") {
+					result = append(result, strings.Trim(a, "\""))
+				}
+			}
+			params[k] = result
+		} else {
+			params[k] = s
+		}
+	}
+	if parametersJSON != "" {
+		if err := json.Unmarshal([]byte(parametersJSON), &params); err != nil {
+			return nil, fmt.Errorf("[ERROR] parameters_json must be a JSON object: %s", err)
+		}
+	}
+	return params, nil
+}
+
+func getResourceInstanceParametersSchemas(meta interface{}, service, plan string) (*resourceInstanceParametersPlanSchemas, error) {
+	key := service + "/" + plan
+	resourceInstanceParametersSchemas.Lock()
+	defer resourceInstanceParametersSchemas.Unlock()
+	if planSchemas, ok := resourceInstanceParametersSchemas.schemas[key]; ok {
+		return planSchemas, nil
+	}
+
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return nil, err
+	}
+	getter, ok := rsCatClient.(catalogPlanGetter)
+	if !ok {
+		return nil, fmt.Errorf("the resource catalog client does not support catalog entries")
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+	serviceOff, err := rsCatRepo.FindByName(service, true)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving service offering: %s", err)
+	}
+	planID, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving plan: %s", err)
+	}
+	if planID == "" {
+		return nil, fmt.Errorf("plan %s not found", plan)
+	}
+
+	entry := map[string]interface{}{}
+	if _, err := getter.Get(fmt.Sprintf("/api/v1/%s?include=*", planID), &entry); err != nil {
+		return nil, fmt.Errorf("Error retrieving plan %s: %s", planID, err)
+	}
+	planSchemas := resourceInstanceParametersSchemasFromEntry(entry)
+	resourceInstanceParametersSchemas.schemas[key] = planSchemas
+	return planSchemas, nil
+}
+
+// resourceInstanceParametersSchemasFromEntry returns the Open Service Broker parameter schemas of a plan catalog entry,
+// found in metadata.schemas.service_instance
+func resourceInstanceParametersSchemasFromEntry(entry map[string]interface{}) *resourceInstanceParametersPlanSchemas {
+	instance := catalogEntryObject(entry, "metadata", "schemas", "service_instance")
+	if instance == nil {
+		return nil
+	}
+	planSchemas := &resourceInstanceParametersPlanSchemas{
+		Create: catalogEntryObject(instance, "create", "parameters"),
+		Update: catalogEntryObject(instance, "update", "parameters"),
+	}
+	if planSchemas.Create == nil && planSchemas.Update == nil {
+		return nil
+	}
+	return planSchemas
+}
+
+func catalogEntryObject(object map[string]interface{}, path ...string) map[string]interface{} {
+	for _, key := range path {
+		next, ok := object[key].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		object = next
+	}
+	return object
+}
+
+// validateResourceInstanceParameters validates parameters against the subset of JSON schema used by service brokers. It
+// returns the field-level errors and the sorted paths of the deprecated parameters that are set
+func validateResourceInstanceParameters(paramsSchema map[string]interface{}, params map[string]interface{}) ([]string, []string) {
+	v := &parametersValidator{}
+	v.validate("parameters", paramsSchema, params)
+	sort.Strings(v.errs)
+	sort.Strings(v.deprecated)
+	return v.errs, v.deprecated
+}
+
+type parametersValidator struct {
+	errs       []string
+	deprecated []string
+}
+
+func (v *parametersValidator) errorf(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *parametersValidator) validate(path string, s map[string]interface{}, value interface{}) {
+	if deprecated, _ := s["deprecated"].(bool); deprecated {
+		v.deprecated = append(v.deprecated, path)
+	}
+
+	if types := schemaTypes(s["type"]); len(types) > 0 {
+		matched := false
+		for _, t := range types {
+			if valueHasSchemaType(value, t) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.errorf(path, "must be of type %s", strings.Join(types, " or "))
+			return
+		}
+	}
+
+	if enum, ok := s["enum"].([]interface{}); ok && len(enum) > 0 {
+		found := false
+		for _, e := range enum {
+			if schemaValuesEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			allowed := make([]string, 0, len(enum))
+			for _, e := range enum {
+				allowed = append(allowed, fmt.Sprintf("%v", e))
+			}
+			v.errorf(path, "must be one of %s", strings.Join(allowed, ", "))
+		}
+	}
+
+	switch val := value.(type) {
+	case map[string]interface{}:
+		v.validateObject(path, s, val)
+	case []interface{}:
+		v.validateArray(path, s, val)
+	case string:
+		v.validateString(path, s, val)
+	}
+	if n, ok := schemaNumber(value); ok {
+		v.validateNumber(path, s, n)
+	}
+}
+
+func (v *parametersValidator) validateObject(path string, s map[string]interface{}, object map[string]interface{}) {
+	properties, _ := s["properties"].(map[string]interface{})
+	if required, ok := s["required"].([]interface{}); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, set := object[name]; !set {
+					v.errorf(path+"."+name, "is required")
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			v.validate(path+"."+name, property, object[name])
+			continue
+		}
+		switch additional := s["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				if suggestion := closestPropertyName(name, properties); suggestion != "" {
+					v.errorf(path+"."+name, "is not a supported parameter, did you mean %s?", suggestion)
+				} else {
+					v.errorf(path+"."+name, "is not a supported parameter")
+				}
+			}
+		case map[string]interface{}:
+			v.validate(path+"."+name, additional, object[name])
+		}
+	}
+}
+
+func (v *parametersValidator) validateArray(path string, s map[string]interface{}, array []interface{}) {
+	if min, ok := schemaNumber(s["minItems"]); ok && float64(len(array)) < min {
+		v.errorf(path, "must have at least %v items", min)
+	}
+	if max, ok := schemaNumber(s["maxItems"]); ok && float64(len(array)) > max {
+		v.errorf(path, "must have at most %v items", max)
+	}
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		for i, item := range array {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), items, item)
+		}
+	}
+}
+
+func (v *parametersValidator) validateString(path string, s map[string]interface{}, str string) {
+	length := float64(len([]rune(str)))
+	if min, ok := schemaNumber(s["minLength"]); ok && length < min {
+		v.errorf(path, "must be at least %v characters", min)
+	}
+	if max, ok := schemaNumber(s["maxLength"]); ok && length > max {
+		v.errorf(path, "must be at most %v characters", max)
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(str) {
+			v.errorf(path, "must match the regular expression %s", pattern)
+		}
+	}
+}
+
+func (v *parametersValidator) validateNumber(path string, s map[string]interface{}, n float64) {
+	if min, ok := schemaNumber(s["minimum"]); ok && n < min {
+		v.errorf(path, "must be at least %v", min)
+	}
+	if max, ok := schemaNumber(s["maximum"]); ok && n > max {
+		v.errorf(path, "must be at most %v", max)
+	}
+	if min, ok := schemaNumber(s["exclusiveMinimum"]); ok && n <= min {
+		v.errorf(path, "must be greater than %v", min)
+	}
+	if max, ok := schemaNumber(s["exclusiveMaximum"]); ok && n >= max {
+		v.errorf(path, "must be less than %v", max)
+	}
+	if multiple, ok := schemaNumber(s["multipleOf"]); ok && multiple > 0 {
+		if q := n / multiple; math.Abs(q-math.Round(q)) > 1e-9 {
+			v.errorf(path, "must be a multiple of %v", multiple)
+		}
+	}
+}
+
+func schemaTypes(t interface{}) []string {
+	switch t := t.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		types := []string{}
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// valueHasSchemaType checks the JSON type of a value. Values of the parameters map are always strings, so a string
+// holding a number is accepted for number and integer
+func valueHasSchemaType(value interface{}, t string) bool {
+	switch t {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "null":
+		return value == nil
+	case "number":
+		_, ok := schemaNumber(value)
+		return ok
+	case "integer":
+		n, ok := schemaNumber(value)
+		return ok && n == math.Trunc(n)
+	}
+	return true
+}
+
+func schemaNumber(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func schemaValuesEqual(a, b interface{}) bool {
+	if na, ok := schemaNumber(a); ok {
+		if nb, ok := schemaNumber(b); ok {
+			if _, isString := a.(string); isString {
+				return a == b
+			}
+			return na == nb
+		}
+	}
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
+
+// closestPropertyName returns the property within two edits of a misspelt name, if any
+func closestPropertyName(name string, properties map[string]interface{}) string {
+	best, bestDistance := "", 3
+	for property := range properties {
+		d := editDistance(strings.ToLower(name), strings.ToLower(property))
+		if d < bestDistance || (d == bestDistance && best != "" && property < best) {
+			best, bestDistance = property, d
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testParametersSchema = `{
+	"type": "object",
+	"additionalProperties": false,
+	"required": ["members"],
+	"properties": {
+		"members": {"type": "integer", "minimum": 1, "maximum": 20},
+		"disk_encryption_key_crn": {"type": "string", "pattern": "^crn:"},
+		"version": {"type": "string", "enum": ["4.4", "5.0"]},
+		"backup_encryption": {"type": "boolean", "deprecated": true},
+		"allowlist": {
+			"type": "array",
+			"maxItems": 2,
+			"items": {
+				"type": "object",
+				"properties": {"address": {"type": "string"}},
+				"required": ["address"]
+			}
+		}
+	}
+}`
+
+func testSchema(t *testing.T) map[string]interface{} {
+	s := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(testParametersSchema), &s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestValidateResourceInstanceParameters(t *testing.T) {
+	params, err := resourceInstanceParametersFromConfig(map[string]interface{}{
+		"members":           "3",
+		"version":           "5.0",
+		"backup_encryption": "true",
+	}, "")
+	assert.Nil(t, err)
+	errs, deprecated := validateResourceInstanceParameters(testSchema(t), params)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"parameters.backup_encryption"}, deprecated)
+
+	params, err = resourceInstanceParametersFromConfig(nil, `{
+		"membres": 3,
+		"version": "6.0",
+		"disk_encryption_key_crn": "key",
+		"allowlist": [{"address": "10.0.0.1"}, {}, {"address": 1}]
+	}`)
+	assert.Nil(t, err)
+	errs, deprecated = validateResourceInstanceParameters(testSchema(t), params)
+	assert.Empty(t, deprecated)
+	assert.Equal(t, []string{
+		"parameters.allowlist: must have at most 2 items",
+		"parameters.allowlist[1].address: is required",
+		"parameters.allowlist[2].address: must be of type string",
+		"parameters.disk_encryption_key_crn: must match the regular expression ^crn:",
+		"parameters.members: is required",
+		"parameters.membres: is not a supported parameter, did you mean members?",
+		"parameters.version: must be one of 4.4, 5.0",
+	}, errs)
+
+	errs, _ = validateResourceInstanceParameters(testSchema(t), map[string]interface{}{"members": 2.5})
+	assert.Equal(t, []string{"parameters.members: must be of type integer"}, errs)
+	errs, _ = validateResourceInstanceParameters(testSchema(t), map[string]interface{}{"members": "30"})
+	assert.Equal(t, []string{"parameters.members: must be at most 20"}, errs)
+}
+
+func TestResourceInstanceParametersFromConfig(t *testing.T) {
+	params, err := resourceInstanceParametersFromConfig(map[string]interface{}{
+		"enabled": "false",
+		"zones":   `["us-south-1","us-south-2"]`,
+		"empty":   "[]",
+		"name":    "db",
+	}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"enabled": false,
+		"zones":   []interface{}{"us-south-1", "us-south-2"},
+		"empty":   []interface{}{},
+		"name":    "db",
+	}, params)
+
+	_, err = resourceInstanceParametersFromConfig(nil, "[1]")
+	assert.NotNil(t, err)
+}
+
+func TestResourceInstanceParametersSchemasFromEntry(t *testing.T) {
+	entry := map[string]interface{}{}
+	assert.Nil(t, resourceInstanceParametersSchemasFromEntry(entry))
+
+	err := json.Unmarshal([]byte(`{"metadata": {"schemas": {"service_instance": {"create": {"parameters": {"type": "object"}}}}}}`), &entry)
+	assert.Nil(t, err)
+	planSchemas := resourceInstanceParametersSchemasFromEntry(entry)
+	assert.Equal(t, map[string]interface{}{"type": "object"}, planSchemas.Create)
+	assert.Nil(t, planSchemas.Update)
+}
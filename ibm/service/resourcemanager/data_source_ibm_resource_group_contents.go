@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMResourceGroupContents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMResourceGroupContentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_id": {
+				Description: "The ID of the resource group",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_count": {
+				Description: "The number of resource instances in the resource group",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"resources": {
+				Description: "The resource instances in the resource group, including the instances pending reclamation",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the instance",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the instance",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"crn": {
+							Description: "The CRN of the instance",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"service_name": {
+							Description: "The name of the service of the instance",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Description: "The state of the instance",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the instance, for example `service_instance`",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"region_id": {
+							Description: "The region of the instance",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locked": {
+							Description: "Whether the instance is locked",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceGroupContentsRead(d *schema.ResourceData, meta interface{}) error {
+	resourceGroupID := d.Get("resource_group_id").(string)
+	instances, err := listResourceGroupInstances(meta, resourceGroupID)
+	if err != nil {
+		return err
+	}
+
+	resources := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		resources = append(resources, flattenResourceGroupInstance(instance))
+	}
+
+	d.SetId(resourceGroupID)
+	if err = d.Set("resources", resources); err != nil {
+		return fmt.Errorf("[ERROR] Error setting resources: %s", err)
+	}
+	d.Set("resource_count", len(resources))
+	return nil
+}
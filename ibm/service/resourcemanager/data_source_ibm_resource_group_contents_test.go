@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceGroupContentsDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-rg-contents-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceGroupContentsDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_group_contents.contents", "resource_count", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_resource_group_contents.contents", "resources.0.crn", "ibm_resource_instance.instance", "crn"),
+					resource.TestCheckResourceAttr("data.ibm_resource_group_contents.contents", "resources.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_resource_group_contents.contents", "resources.0.service_name", "kms"),
+					resource.TestCheckResourceAttr("data.ibm_resource_group_contents.contents", "resources.0.state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceGroupContentsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_group" "group" {
+		name = "%s"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+		resource_group_id = ibm_resource_group.group.id
+	}
+
+	data "ibm_resource_group_contents" "contents" {
+		resource_group_id = ibm_resource_instance.instance.resource_group_id
+	}
+`, name, name)
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// resourceGroupContentStates are the states of the instances that block the deletion of a resource group, the
+// resource controller only lists active and provisioning instances by default
+var resourceGroupContentStates = []string{
+	rc.ListResourceInstancesOptionsStateActiveConst,
+	rc.ListResourceInstancesOptionsStateProvisioningConst,
+	rc.ListResourceInstancesOptionsStatePreProvisioningConst,
+	rc.ListResourceInstancesOptionsStateInactiveConst,
+	rc.ListResourceInstancesOptionsStateFailedConst,
+	rc.ListResourceInstancesOptionsStatePendingReclamationConst,
+}
+
+// listResourceGroupInstances lists the resource instances of a resource group in all the states that block its
+// deletion, with one list request per state. Only the resource controller instances are listed, the infrastructure
+// resources such as VPC resources are not
+func listResourceGroupInstances(meta interface{}, resourceGroupID string) ([]rc.ResourceInstance, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+
+	instances := []rc.ResourceInstance{}
+	for _, state := range resourceGroupContentStates {
+		listOptions := rc.ListResourceInstancesOptions{}
+		listOptions.SetResourceGroupID(resourceGroupID)
+		listOptions.SetState(state)
+		for {
+			list, resp, err := rsConClient.ListResourceInstances(&listOptions)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error listing the %s resource instances of resource group %s: %s with response code  %s", state, resourceGroupID, err, resp)
+			}
+			instances = append(instances, list.Resources...)
+			start, err := list.GetNextStart()
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error listing the resource instances of resource group %s, the next_url cannot be parsed: %s", resourceGroupID, err)
+			}
+			if start == nil {
+				break
+			}
+			listOptions.Start = start
+		}
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		return flex.StringValue(instances[i].CRN) < flex.StringValue(instances[j].CRN)
+	})
+	return instances, nil
+}
+
+func flattenResourceGroupInstance(instance rc.ResourceInstance) map[string]interface{} {
+	crn := flex.StringValue(instance.CRN)
+	serviceName := ""
+	if parsed, err := flex.Parse(crn); err == nil {
+		serviceName = parsed.ServiceName
+	}
+	return map[string]interface{}{
+		"id":           flex.StringValue(instance.ID),
+		"name":         flex.StringValue(instance.Name),
+		"crn":          crn,
+		"service_name": serviceName,
+		"state":        flex.StringValue(instance.State),
+		"type":         flex.StringValue(instance.Type),
+		"region_id":    flex.StringValue(instance.RegionID),
+		"locked":       instance.Locked != nil && *instance.Locked,
+	}
+}
+
+// resourceGroupContentsSummary describes the instances that block the deletion of a resource group, one per line
+func resourceGroupContentsSummary(instances []rc.ResourceInstance) string {
+	lines := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		item := flattenResourceGroupInstance(instance)
+		lines = append(lines, fmt.Sprintf("  - %s (%s, %s) %s", item["name"], item["service_name"], item["state"], item["crn"]))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenResourceGroupInstance(t *testing.T) {
+	instance := rc.ResourceInstance{
+		ID:       core.StringPtr("crn:v1:bluemix:public:kms:us-south:a/account:instance::"),
+		CRN:      core.StringPtr("crn:v1:bluemix:public:kms:us-south:a/account:instance::"),
+		Name:     core.StringPtr("keys"),
+		State:    core.StringPtr("pending_reclamation"),
+		Type:     core.StringPtr("service_instance"),
+		RegionID: core.StringPtr("us-south"),
+	}
+	assert.Equal(t, map[string]interface{}{
+		"id":           "crn:v1:bluemix:public:kms:us-south:a/account:instance::",
+		"name":         "keys",
+		"crn":          "crn:v1:bluemix:public:kms:us-south:a/account:instance::",
+		"service_name": "kms",
+		"state":        "pending_reclamation",
+		"type":         "service_instance",
+		"region_id":    "us-south",
+		"locked":       false,
+	}, flattenResourceGroupInstance(instance))
+
+	assert.Equal(t, "  - keys (kms, pending_reclamation) crn:v1:bluemix:public:kms:us-south:a/account:instance::",
+		resourceGroupContentsSummary([]rc.ResourceInstance{instance}))
+
+	instance.CRN = core.StringPtr("instance")
+	assert.Equal(t, "", flattenResourceGroupInstance(instance)["service_name"])
+}
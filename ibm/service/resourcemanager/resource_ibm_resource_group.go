@@ -1,4 +1,4 @@
-// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Copyright IBM Corp. 2017, 2024 All Rights Reserved.
 // Licensed under the Mozilla Public License v2.0
 
 package resourcemanager
@@ -86,6 +86,17 @@ func ResourceIBMResourceGroup() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Computed:    true,
 			},
+			"prevent_destroy_if_not_empty": {
+				Type:        schema.TypeBool,
+				Description: "Whether the destroy of the resource group fails before deleting it when the resource group still contains resource controller instances",
+				Optional:    true,
+				Default:     false,
+			},
+			"resource_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of resource controller instances in the resource group, including the instances pending reclamation. Only set when prevent_destroy_if_not_empty is true, cleared otherwise or when the instances cannot be listed",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -175,6 +186,21 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		d.Set("resource_linkages", rl)
 	}
+	// The instances are only counted for the resource groups that check their contents before they are deleted, the
+	// count is cleared otherwise so that no stale count is kept in the state
+	if d.Get("prevent_destroy_if_not_empty").(bool) {
+		instances, err := listResourceGroupInstances(meta, resourceGroupID)
+		if err != nil {
+			log.Printf("[WARN] The resource instances of resource group %s could not be counted: %s", resourceGroupID, err)
+			d.Set("resource_count", nil)
+		} else {
+			d.Set("resource_count", len(instances))
+		}
+	} else {
+		d.Set("resource_count", nil)
+	}
+	// Sets the default on import
+	d.Set("prevent_destroy_if_not_empty", d.Get("prevent_destroy_if_not_empty").(bool))
 	return nil
 }
 
@@ -212,6 +238,17 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	resourceGroupID := d.Id()
+	if d.Get("prevent_destroy_if_not_empty").(bool) {
+		instances, err := listResourceGroupInstances(meta, resourceGroupID)
+		if err != nil {
+			return err
+		}
+		if len(instances) > 0 {
+			return fmt.Errorf("[ERROR] Resource group %s is not deleted because prevent_destroy_if_not_empty is set and it still contains %d resource controller instances:\n%s",
+				resourceGroupID, len(instances), resourceGroupContentsSummary(instances))
+		}
+	}
+
 	resourceGroupDelete := rg.DeleteResourceGroupOptions{
 		ID: &resourceGroupID,
 	}
@@ -222,6 +259,11 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 			log.Printf("[WARN] Resource Group is not found")
 			return nil
 		}
+		// Show what blocks the deletion, the response only says that the resource group is not empty
+		if instances, listErr := listResourceGroupInstances(meta, resourceGroupID); listErr == nil && len(instances) > 0 {
+			return fmt.Errorf("[ERROR] Error Deleting resource group: %s with response code  %s\nThe resource group still contains %d resource instances:\n%s",
+				err, resp, len(instances), resourceGroupContentsSummary(instances))
+		}
 		return fmt.Errorf("[ERROR] Error Deleting resource group: %s with response code  %s", err, resp)
 	}
 
@@ -80,6 +80,33 @@ func TestAccIBMResourceGroupWithTags(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceGroupPreventDestroyIfNotEmpty(t *testing.T) {
+	var conf string
+	resourceGroupName := fmt.Sprintf("tf-rg-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceGroupPreventDestroy(resourceGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceGroupExists("ibm_resource_group.resourceGroup", &conf),
+					resource.TestCheckResourceAttr("ibm_resource_group.resourceGroup", "prevent_destroy_if_not_empty", "true"),
+					resource.TestCheckResourceAttr("ibm_resource_group.resourceGroup", "resource_count", "0"),
+				),
+			},
+			{
+				ResourceName:      "ibm_resource_group.resourceGroup",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"prevent_destroy_if_not_empty"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMResourceGroupExists(n string, obj *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -167,3 +194,13 @@ func testAccCheckIBMResourceGroupWithupdatedTags(resourceGroupName string) strin
 		  }
 	`, resourceGroupName)
 }
+
+func testAccCheckIBMResourceGroupPreventDestroy(resourceGroupName string) string {
+	return fmt.Sprintf(`
+
+		  resource "ibm_resource_group" "resourceGroup" {
+			name                         = "%s"
+			prevent_destroy_if_not_empty = true
+		  }
+	`, resourceGroupName)
+}
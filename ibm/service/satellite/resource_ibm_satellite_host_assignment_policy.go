@@ -0,0 +1,521 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	policyWorkerPools           = "worker_pool"
+	policyWorkerPoolName        = "name"
+	policyDesiredCount          = "desired_count"
+	policyZones                 = "zones"
+	policyHostLabels            = "host_labels"
+	policyReplaceUnhealthyHosts = "replace_unhealthy_hosts"
+	policyAssignedHosts         = "assigned_hosts"
+	policyPendingHosts          = "pending_hosts"
+	policyInSync                = "in_sync"
+
+	rsHostUnresponsiveStatus   = "unresponsive"
+	rsHostReloadRequiredStatus = "reload-required"
+)
+
+func ResourceIBMSatelliteHostAssignmentPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMSatelliteHostAssignmentPolicyCreate,
+		Read:     resourceIBMSatelliteHostAssignmentPolicyRead,
+		Update:   resourceIBMSatelliteHostAssignmentPolicyUpdate,
+		Delete:   resourceIBMSatelliteHostAssignmentPolicyDelete,
+		Importer: &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMSatelliteHostAssignmentPolicyDiff(diff)
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			hostLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			hostCluster: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite location or cluster to assign hosts to. Defaults to the location control plane",
+			},
+			policyWorkerPools: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Worker pools to keep populated with attached hosts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						policyWorkerPoolName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name or ID of the worker pool",
+						},
+						policyDesiredCount: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of hosts to keep assigned to the worker pool. Unhealthy hosts only count when replace_unhealthy_hosts is false",
+						},
+						policyZones: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The zones to balance hosts across. If not set, the zone is chosen by Satellite",
+						},
+						policyHostLabels: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^:]+:[^:]+$`), "must be in key:value format"),
+							},
+							Set:         schema.HashString,
+							Description: "Labels that an unassigned host must have to be assigned to the worker pool, in key:value format",
+						},
+					},
+				},
+			},
+			policyReplaceUnhealthyHosts: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove assigned hosts that are unresponsive or require a reload from the location after a healthy replacement is assigned",
+			},
+			policyAssignedHosts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts assigned to the worker pools of the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						hostID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the host",
+						},
+						hostWorkerPool: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The worker pool the host is assigned to",
+						},
+						hostZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone the host is assigned to",
+						},
+						hostState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the host",
+						},
+					},
+				},
+			},
+			policyPendingHosts: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The number of hosts each worker pool is still missing",
+			},
+			policyInSync: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every worker pool has its desired count of hosts",
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get(hostLocation).(string)
+	cluster := location
+	if v, ok := d.GetOk(hostCluster); ok {
+		cluster = v.(string)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", location, cluster))
+
+	if err := reconcileSatelliteHostAssignmentPolicy(d, meta, location, cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceIBMSatelliteHostAssignmentPolicyRead(d, meta)
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/cluster", d.Id())
+	}
+	location := parts[0]
+	cluster := parts[1]
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+	}
+
+	d.Set(hostLocation, location)
+	d.Set(hostCluster, cluster)
+
+	assigned := make([]map[string]interface{}, 0)
+	pending := make(map[string]interface{})
+	inSync := true
+	replaceUnhealthy := d.Get(policyReplaceUnhealthyHosts).(bool)
+	for _, p := range d.Get(policyWorkerPools).([]interface{}) {
+		pool := p.(map[string]interface{})
+		poolName := pool[policyWorkerPoolName].(string)
+		healthy, unhealthy := satelliteWorkerPoolHosts(hostList, cluster, poolName)
+		for _, h := range append(healthy, unhealthy...) {
+			assigned = append(assigned, flattenSatellitePolicyHost(h))
+		}
+		missing := satelliteWorkerPoolMissingHosts(pool[policyDesiredCount].(int), healthy, unhealthy, replaceUnhealthy)
+		if missing > 0 {
+			pending[poolName] = missing
+			inSync = false
+		}
+		if replaceUnhealthy && len(unhealthy) > 0 {
+			inSync = false
+		}
+	}
+
+	d.Set(policyAssignedHosts, assigned)
+	d.Set(policyPendingHosts, pending)
+	d.Set(policyInSync, inSync)
+
+	return nil
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := reconcileSatelliteHostAssignmentPolicy(d, meta, parts[0], parts[1], d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceIBMSatelliteHostAssignmentPolicyRead(d, meta)
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	// Assigned hosts are left in place; removing them would destroy the workers they run
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMSatelliteHostAssignmentPolicyDiff plans an update whenever the last refresh found a
+// worker pool below its desired count or with unhealthy hosts to replace.
+func resourceIBMSatelliteHostAssignmentPolicyDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if inSync, ok := diff.GetOk(policyInSync); !ok || !inSync.(bool) {
+		if err := diff.SetNewComputed(policyAssignedHosts); err != nil {
+			return err
+		}
+		if err := diff.SetNewComputed(policyPendingHosts); err != nil {
+			return err
+		}
+		return diff.SetNewComputed(policyInSync)
+	}
+	return nil
+}
+
+// reconcileSatelliteHostAssignmentPolicy assigns matching unassigned hosts to every worker pool that
+// is below its desired count, balancing them across the pool zones, and optionally removes unhealthy
+// hosts once their replacement is assigned.
+func reconcileSatelliteHostAssignmentPolicy(d *schema.ResourceData, meta interface{}, location, cluster string, timeout time.Duration) error {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+	}
+
+	replaceUnhealthy := d.Get(policyReplaceUnhealthyHosts).(bool)
+	picked := make(map[string]bool)
+	newlyAssigned := make([]string, 0)
+	toRemove := make([]string, 0)
+
+	for _, p := range d.Get(policyWorkerPools).([]interface{}) {
+		pool := p.(map[string]interface{})
+		poolName := pool[policyWorkerPoolName].(string)
+		zones := flex.ExpandStringList(pool[policyZones].([]interface{}))
+		selector := flex.FlattenKeyValues(pool[policyHostLabels].(*schema.Set).List())
+
+		healthy, unhealthy := satelliteWorkerPoolHosts(hostList, cluster, poolName)
+		zoneCounts := make(map[string]int)
+		for _, zone := range zones {
+			zoneCounts[zone] = 0
+		}
+		for _, h := range healthy {
+			if h.Assignment != nil {
+				zoneCounts[flex.StringValue(h.Assignment.Zone)]++
+			}
+		}
+
+		missing := satelliteWorkerPoolMissingHosts(pool[policyDesiredCount].(int), healthy, unhealthy, replaceUnhealthy)
+		for missing > 0 {
+			zone := leastPopulatedSatelliteZone(zones, zoneCounts)
+			host := pickSatelliteHostCandidate(hostList, picked, selector, zone)
+			if host == nil {
+				log.Printf("[WARN] Satellite worker pool %s needs %d more hosts but no unassigned host in location %s matches", poolName, missing, location)
+				break
+			}
+
+			hostNameOrID := flex.StringValue(host.ID)
+			hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{
+				Controller: &location,
+				Cluster:    &cluster,
+				HostID:     &hostNameOrID,
+				Workerpool: &poolName,
+				Labels:     selector,
+			}
+			if zone != "" {
+				hostAssignOptions.Zone = &zone
+			}
+			_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error Assigning Satellite Host (%s) to worker pool (%s): %s\n%s", hostNameOrID, poolName, err, response)
+			}
+
+			picked[hostNameOrID] = true
+			newlyAssigned = append(newlyAssigned, hostNameOrID)
+			zoneCounts[zone]++
+			missing--
+		}
+
+		// an unhealthy host is only removed when the pool still has its desired count without it
+		if replaceUnhealthy && missing <= 0 {
+			for _, h := range unhealthy {
+				toRemove = append(toRemove, flex.StringValue(h.ID))
+			}
+		}
+	}
+
+	for _, hostNameOrID := range newlyAssigned {
+		_, err = waitForSatelliteHostNormal(hostNameOrID, location, timeout, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostNameOrID, err)
+		}
+	}
+
+	for _, hostNameOrID := range toRemove {
+		log.Printf("[INFO] Removing unhealthy Satellite host %s from location %s", hostNameOrID, location)
+		removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{
+			Controller: &location,
+			HostID:     flex.PtrToString(hostNameOrID),
+		}
+		response, err := satClient.RemoveSatelliteHost(removeSatHostOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error Removing unhealthy Satellite Host (%s): %s\n%s", hostNameOrID, err, response)
+		}
+	}
+
+	return nil
+}
+
+// satelliteWorkerPoolMissingHosts returns the number of hosts to assign to a worker pool. Unhealthy
+// hosts that are not replaced stay in the pool, so they count against the desired count.
+func satelliteWorkerPoolMissingHosts(desired int, healthy, unhealthy []kubernetesserviceapiv1.MultishiftQueueNode, replaceUnhealthy bool) int {
+	if replaceUnhealthy {
+		return desired - len(healthy)
+	}
+	return desired - len(healthy) - len(unhealthy)
+}
+
+// satelliteWorkerPoolHosts splits the hosts assigned to a worker pool by their health.
+func satelliteWorkerPoolHosts(hostList []kubernetesserviceapiv1.MultishiftQueueNode, cluster, poolName string) (healthy, unhealthy []kubernetesserviceapiv1.MultishiftQueueNode) {
+	for _, h := range hostList {
+		if h.Assignment == nil {
+			continue
+		}
+		if cluster != flex.StringValue(h.Assignment.ClusterID) && cluster != flex.StringValue(h.Assignment.ClusterName) {
+			continue
+		}
+		if poolName != flex.StringValue(h.Assignment.WorkerPoolName) && poolName != flex.StringValue(h.Assignment.WorkerPoolID) {
+			continue
+		}
+		if isSatelliteHostUnhealthy(h) {
+			unhealthy = append(unhealthy, h)
+		} else {
+			healthy = append(healthy, h)
+		}
+	}
+	return
+}
+
+func isSatelliteHostUnhealthy(h kubernetesserviceapiv1.MultishiftQueueNode) bool {
+	if h.Health == nil {
+		return false
+	}
+	status := flex.StringValue(h.Health.Status)
+	return status == rsHostUnresponsiveStatus || status == rsHostReloadRequiredStatus
+}
+
+// pickSatelliteHostCandidate returns a ready, unassigned host that carries all selector labels,
+// preferring a host whose zone label matches the target zone.
+func pickSatelliteHostCandidate(hostList []kubernetesserviceapiv1.MultishiftQueueNode, picked map[string]bool, selector map[string]string, zone string) *kubernetesserviceapiv1.MultishiftQueueNode {
+	var candidates []*kubernetesserviceapiv1.MultishiftQueueNode
+	for i := range hostList {
+		h := &hostList[i]
+		if picked[flex.StringValue(h.ID)] {
+			continue
+		}
+		if h.Assignment != nil && flex.StringValue(h.Assignment.ClusterID) != "" {
+			continue
+		}
+		if h.Health == nil || flex.StringValue(h.Health.Status) != rsHostReadyStatus {
+			continue
+		}
+		if !satelliteHostLabelsMatch(h.Labels, selector) {
+			continue
+		}
+		candidates = append(candidates, h)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return flex.StringValue(candidates[i].Name) < flex.StringValue(candidates[j].Name)
+	})
+	if zone != "" {
+		for _, h := range candidates {
+			if h.Labels[hostZone] == zone {
+				return h
+			}
+		}
+	}
+	return candidates[0]
+}
+
+func satelliteHostLabelsMatch(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// leastPopulatedSatelliteZone returns the zone with the fewest hosts, or an empty string when no
+// zones are configured.
+func leastPopulatedSatelliteZone(zones []string, zoneCounts map[string]int) string {
+	zone := ""
+	for _, z := range zones {
+		if zone == "" || zoneCounts[z] < zoneCounts[zone] {
+			zone = z
+		}
+	}
+	return zone
+}
+
+func flattenSatellitePolicyHost(h kubernetesserviceapiv1.MultishiftQueueNode) map[string]interface{} {
+	host := map[string]interface{}{
+		hostID:      flex.StringValue(h.ID),
+		"host_name": flex.StringValue(h.Name),
+	}
+	if h.Assignment != nil {
+		host[hostWorkerPool] = flex.StringValue(h.Assignment.WorkerPoolName)
+		host[hostZone] = flex.StringValue(h.Assignment.Zone)
+	}
+	if h.Health != nil {
+		host[hostState] = flex.StringValue(h.Health.Status)
+	}
+	return host
+}
+
+func waitForSatelliteHostNormal(hostNameOrID, location string, timeout time.Duration, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostProvisioningStatus, rsHostUnknownStatus, rsHostReadyStatus},
+		Target:  []string{rsHostNormalStatus},
+		Refresh: func() (interface{}, string, error) {
+			hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			}
+			hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting hosts of Satellite location (%s): %s\n%s", location, err, resp)
+			}
+			for _, h := range hostList {
+				if hostNameOrID != flex.StringValue(h.Name) && hostNameOrID != flex.StringValue(h.ID) {
+					continue
+				}
+				if isSatelliteHostUnhealthy(h) {
+					return h, flex.StringValue(h.Health.Status), fmt.Errorf("[ERROR] The satellite host (%s) is %s", hostNameOrID, flex.StringValue(h.Health.Status))
+				}
+				if h.Health != nil && flex.StringValue(h.Health.Status) == rsHostNormalStatus {
+					return h, rsHostNormalStatus, nil
+				}
+			}
+			return hostNameOrID, rsHostProvisioningStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      60 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSatelliteHostAssignmentPolicy_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSatelliteHostAssignmentPolicy(acc.Satellite_location_id, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "location", acc.Satellite_location_id),
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "in_sync", "true"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "assigned_hosts.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteHostAssignmentPolicy(location string, count int) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_host_assignment_policy" "policy" {
+		location = "%s"
+
+		worker_pool {
+			name          = "default"
+			desired_count = %d
+			host_labels   = ["env:prod"]
+		}
+	}
+`, location, count)
+}
@@ -0,0 +1,363 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmRotationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmRotationPolicyCreate,
+		ReadContext:   resourceIbmSmRotationPolicyRead,
+		UpdateContext: resourceIbmSmRotationPolicyUpdate,
+		DeleteContext: resourceIbmSmRotationPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret to which the rotation policy applies.",
+			},
+			"secret_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_sm_rotation_policy", "secret_type"),
+				Description:  "The secret type. Supported types are arbitrary, kv, username_password, iam_credentials, service_credentials and private_cert.",
+			},
+			"auto_rotate": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether the secret is rotated automatically.",
+			},
+			"interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_sm_rotation_policy", "interval"),
+				Description:  "The length of the secret rotation time interval.",
+			},
+			"unit": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_sm_rotation_policy", "unit"),
+				Description:  "The units for the secret rotation time interval, day or month.",
+			},
+			"service_managed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rotation is scheduled by Secrets Manager. Secrets of type arbitrary and kv are scheduled by Terraform.",
+			},
+			"next_rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the secret is rotated next.",
+			},
+			"rotation_trigger": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "For secrets that are scheduled by Terraform, a value that changes every time a rotation is due. Use it in the triggers of ibm_sm_secret_version_rotate.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the rotation policy was created. The rotations scheduled by Terraform are counted from this date.",
+			},
+		},
+	}
+}
+
+func ResourceIbmSmRotationPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "secret_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              strings.Join([]string{ArbitrarySecretType, KvSecretType, UsernamePasswordSecretType, IAMCredentialsSecretType, ServiceCredentialsSecretType, PrivateCertSecretType}, ","),
+		},
+		validate.ValidateSchema{
+			Identifier:                 "interval",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "1095",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "unit",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              strings.Join([]string{smRotationPolicyUnitDay, smRotationPolicyUnitMonth}, ","),
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_sm_rotation_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// smRotationPolicyServiceManaged returns whether Secrets Manager rotates secrets of the given type by itself.
+// Arbitrary and kv secrets have no rotation policy in Secrets Manager, their schedule is evaluated by Terraform.
+func smRotationPolicyServiceManaged(secretType string) bool {
+	return secretType != ArbitrarySecretType && secretType != KvSecretType
+}
+
+func resourceIbmSmRotationPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	if smRotationPolicyServiceManaged(d.Get("secret_type").(string)) {
+		if err = resourceIbmSmRotationPolicyUpdateSecret(context, secretsManagerClient, d, d.Get("auto_rotate").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, instanceId, secretId))
+	if err = d.Set("created_at", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+
+	return resourceIbmSmRotationPolicyRead(context, d, meta)
+}
+
+func resourceIbmSmRotationPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 3 {
+		return diag.Errorf("Wrong format of resource ID. To import a rotation policy use the format `<region>/<instance_id>/<secret_id>`")
+	}
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response))
+	}
+	secretMetadata, err := smRotationFieldsOf(secretMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("secret_type", secretMetadata.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	// An imported policy of a secret scheduled by Terraform starts counting its rotations at the import
+	if _, ok := d.GetOk("created_at"); !ok {
+		if err = d.Set("created_at", time.Now().UTC().Format(time.RFC3339)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+		}
+	}
+
+	serviceManaged := smRotationPolicyServiceManaged(secretMetadata.SecretType)
+	if err = d.Set("service_managed", serviceManaged); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting service_managed: %s", err))
+	}
+	if serviceManaged {
+		return resourceIbmSmRotationPolicyReadServiceManaged(d, secretMetadata)
+	}
+	return resourceIbmSmRotationPolicyReadSchedule(d)
+}
+
+// resourceIbmSmRotationPolicyReadServiceManaged sets the rotation policy that Secrets Manager holds for the secret
+func resourceIbmSmRotationPolicyReadServiceManaged(d *schema.ResourceData, secretMetadata smRotationFields) diag.Diagnostics {
+	var err error
+	if rotation := secretMetadata.Rotation; rotation != nil {
+		if err = d.Set("auto_rotate", rotation.AutoRotate); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting auto_rotate: %s", err))
+		}
+		if rotation.Interval != 0 {
+			if err = d.Set("interval", rotation.Interval); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting interval: %s", err))
+			}
+		}
+		if rotation.Unit != "" {
+			if err = d.Set("unit", rotation.Unit); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting unit: %s", err))
+			}
+		}
+	} else if err = d.Set("auto_rotate", false); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting auto_rotate: %s", err))
+	}
+	if err = d.Set("next_rotation_date", secretMetadata.NextRotationDate); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
+	}
+	if err = d.Set("rotation_trigger", ""); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation_trigger: %s", err))
+	}
+	return nil
+}
+
+// resourceIbmSmRotationPolicyReadSchedule evaluates the schedule of a secret that Secrets Manager doesn't rotate by
+// itself. The rotation trigger only changes when a rotation is due, and keeps its value while auto_rotate is false.
+func resourceIbmSmRotationPolicyReadSchedule(d *schema.ResourceData) diag.Diagnostics {
+	if !d.Get("auto_rotate").(bool) {
+		if err := d.Set("next_rotation_date", ""); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
+		}
+		return nil
+	}
+
+	anchor, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error parsing created_at: %s", err))
+	}
+	index, next, err := smRotationPolicySchedule(anchor, time.Now().UTC(), d.Get("interval").(int), d.Get("unit").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("next_rotation_date", next.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
+	}
+	if err = d.Set("rotation_trigger", strconv.Itoa(index)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation_trigger: %s", err))
+	}
+	return nil
+}
+
+func resourceIbmSmRotationPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("auto_rotate", "interval", "unit") && smRotationPolicyServiceManaged(d.Get("secret_type").(string)) {
+		secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		id := strings.Split(d.Id(), "/")
+		secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, id[1], id[0], getEndpointType(secretsManagerClient, d))
+		if err = resourceIbmSmRotationPolicyUpdateSecret(context, secretsManagerClient, d, d.Get("auto_rotate").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmRotationPolicyRead(context, d, meta)
+}
+
+func resourceIbmSmRotationPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if smRotationPolicyServiceManaged(d.Get("secret_type").(string)) {
+		secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		id := strings.Split(d.Id(), "/")
+		secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, id[1], id[0], getEndpointType(secretsManagerClient, d))
+		if err = resourceIbmSmRotationPolicyUpdateSecret(context, secretsManagerClient, d, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIbmSmRotationPolicyUpdateSecret sets the rotation policy in the metadata of the secret
+func resourceIbmSmRotationPolicyUpdateSecret(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, autoRotate bool) error {
+	rotation := &secretsmanagerv2.CommonRotationPolicy{
+		AutoRotate: core.BoolPtr(autoRotate),
+		Interval:   core.Int64Ptr(int64(d.Get("interval").(int))),
+		Unit:       core.StringPtr(d.Get("unit").(string)),
+	}
+	patch, err := resourceIbmSmRotationPolicyMapToMetadataPatch(d.Get("secret_type").(string), rotation)
+	if err != nil {
+		return err
+	}
+
+	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
+	updateSecretMetadataOptions.SetID(d.Get("secret_id").(string))
+	updateSecretMetadataOptions.SecretMetadataPatch = patch
+
+	_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
+	if err != nil {
+		if !autoRotate && response != nil && response.StatusCode == 404 {
+			// The secret is gone, so is its rotation policy
+			return nil
+		}
+		log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIbmSmRotationPolicyMapToMetadataPatch(secretType string, rotation secretsmanagerv2.RotationPolicyIntf) (map[string]interface{}, error) {
+	switch secretType {
+	case UsernamePasswordSecretType:
+		return (&secretsmanagerv2.UsernamePasswordSecretMetadataPatch{Rotation: rotation}).AsPatch()
+	case IAMCredentialsSecretType:
+		return (&secretsmanagerv2.IAMCredentialsSecretMetadataPatch{Rotation: rotation}).AsPatch()
+	case ServiceCredentialsSecretType:
+		return (&secretsmanagerv2.ServiceCredentialsSecretMetadataPatch{Rotation: rotation}).AsPatch()
+	case PrivateCertSecretType:
+		return (&secretsmanagerv2.PrivateCertificateMetadataPatch{Rotation: rotation}).AsPatch()
+	default:
+		return nil, fmt.Errorf("Secrets Manager doesn't hold a rotation policy for secrets of type %s", secretType)
+	}
+}
+
+// smRotationFields holds the rotation fields of the secret metadata models
+type smRotationFields struct {
+	SecretType string `json:"secret_type"`
+	Rotation   *struct {
+		AutoRotate bool   `json:"auto_rotate"`
+		Interval   int64  `json:"interval"`
+		Unit       string `json:"unit"`
+	} `json:"rotation"`
+	NextRotationDate string `json:"next_rotation_date"`
+}
+
+func smRotationFieldsOf(model interface{}) (smRotationFields, error) {
+	var fields smRotationFields
+	jsonData, err := json.Marshal(model)
+	if err == nil {
+		err = json.Unmarshal(jsonData, &fields)
+	}
+	return fields, err
+}
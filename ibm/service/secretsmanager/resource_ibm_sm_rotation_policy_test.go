@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmRotationPolicyServiceManaged(t *testing.T) {
+	resourceName := "ibm_sm_rotation_policy.sm_rotation_policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmUsernamePasswordSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: rotationPolicyServiceManagedConfig(2, "day"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_managed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_date"),
+					testAccCheckIbmSmRotationPolicy("ibm_sm_username_password_secret.sm_username_password_secret_rotation", "2", "day"),
+				),
+			},
+			{
+				Config: rotationPolicyServiceManagedConfig(1, "month"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unit", "month"),
+					testAccCheckIbmSmRotationPolicy("ibm_sm_username_password_secret.sm_username_password_secret_rotation", "1", "month"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at"},
+			},
+		},
+	})
+}
+
+func TestAccIbmSmRotationPolicyScheduled(t *testing.T) {
+	resourceName := "ibm_sm_rotation_policy.sm_rotation_policy"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: rotationPolicyScheduledConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_managed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr("ibm_sm_secret_version_rotate.sm_secret_version_rotate_scheduled", "triggers.rotation", "0"),
+				),
+			},
+		},
+	})
+}
+
+var rotationPolicyServiceManagedConfigFormat = `
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_rotation" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			name = "%[3]s"
+			username = "%[4]s"
+			password = "%[5]s"
+		}
+
+		resource "ibm_sm_rotation_policy" "sm_rotation_policy" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			secret_id     = ibm_sm_username_password_secret.sm_username_password_secret_rotation.secret_id
+			secret_type   = "username_password"
+			interval      = %[6]d
+			unit          = "%[7]s"
+		}`
+
+func rotationPolicyServiceManagedConfig(interval int, unit string) string {
+	return fmt.Sprintf(rotationPolicyServiceManagedConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		usernamePasswordSecretName+"-rotation", username, password, interval, unit)
+}
+
+var rotationPolicyScheduledConfigFormat = `
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_scheduled" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			name = "%[3]s"
+  			payload = "%[4]s"
+			lifecycle {
+				ignore_changes = [payload]
+			}
+		}
+
+		resource "ibm_sm_rotation_policy" "sm_rotation_policy" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			secret_id     = ibm_sm_arbitrary_secret.sm_arbitrary_secret_scheduled.secret_id
+			secret_type   = "arbitrary"
+			interval      = 30
+			unit          = "day"
+		}
+
+		resource "ibm_sm_secret_version_rotate" "sm_secret_version_rotate_scheduled" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			secret_id     = ibm_sm_arbitrary_secret.sm_arbitrary_secret_scheduled.secret_id
+			secret_type   = "arbitrary"
+			payload       = "%[5]s"
+			triggers = {
+				rotation = ibm_sm_rotation_policy.sm_rotation_policy.rotation_trigger
+			}
+		}`
+
+func rotationPolicyScheduledConfig() string {
+	return fmt.Sprintf(rotationPolicyScheduledConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		arbitrarySecretName+"-scheduled", payload, modifiedPayload)
+}
+
+func testAccCheckIbmSmRotationPolicy(n, interval, unit string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		usernamePasswordSecretIntf, err := getSecret(s, n)
+		if err != nil {
+			return err
+		}
+		secret := usernamePasswordSecretIntf.(*secretsmanagerv2.UsernamePasswordSecret)
+
+		if err := verifyAttr(getAutoRotate(secret.Rotation), "true", "auto_rotate"); err != nil {
+			return err
+		}
+		if err := verifyAttr(getRotationUnit(secret.Rotation), unit, "rotation unit"); err != nil {
+			return err
+		}
+		if err := verifyAttr(getRotationInterval(secret.Rotation), interval, "rotation interval"); err != nil {
+			return err
+		}
+		return nil
+	}
+}
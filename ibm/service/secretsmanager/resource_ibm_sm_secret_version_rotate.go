@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmSecretVersionRotate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretVersionRotateCreate,
+		ReadContext:   resourceIbmSmSecretVersionRotateRead,
+		UpdateContext: resourceIbmSmSecretVersionRotateUpdate,
+		DeleteContext: resourceIbmSmSecretVersionRotateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret to rotate.",
+			},
+			"secret_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_sm_secret_version_rotate", "secret_type"),
+				Description:  "The secret type. Supported types are arbitrary, kv, username_password, iam_credentials and service_credentials.",
+			},
+			"payload": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new payload of an arbitrary secret.",
+			},
+			"data": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The new payload data of a kv secret.",
+			},
+			"password": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new password of a username_password secret. If omitted, Secrets Manager generates a new password.",
+			},
+			"version_custom_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The secret version metadata that a user can customize.",
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, rotate the secret again.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version created by the rotation.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the secret version was created.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func ResourceIbmSmSecretVersionRotateValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "secret_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              strings.Join([]string{ArbitrarySecretType, KvSecretType, UsernamePasswordSecretType, IAMCredentialsSecretType, ServiceCredentialsSecretType}, ","),
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_sm_secret_version_rotate", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmSmSecretVersionRotateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	secretId := d.Get("secret_id").(string)
+	versionModel, err := resourceIbmSmSecretVersionRotateMapToSecretVersionPrototype(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	createSecretVersionOptions.SetSecretVersionPrototype(versionModel)
+
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response))
+	}
+	secretVersion, err := smCommonFieldsOf(secretVersionIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, secretVersion.ID))
+
+	_, err = waitForIbmSmSecretVersionRotate(context, secretsManagerClient, d, secretId, secretVersion.ID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for resource IbmSmSecretVersionRotate (%s) to be created: %s", d.Id(), err))
+	}
+
+	return resourceIbmSmSecretVersionRotateRead(context, d, meta)
+}
+
+// waitForIbmSmSecretVersionRotate waits until the secret is active and the new version is its current version
+func waitForIbmSmSecretVersionRotate(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData, secretId, versionId string) (interface{}, error) {
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID("current")
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pre_activation", "rotating"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, getSecretMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretMetadataWithContext failed %s\n%s", err, response)
+			}
+			secretMetadata, err := smCommonFieldsOf(secretMetadataIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if secretMetadata.StateDescription != "active" {
+				if secretMetadata.StateDescription == "destroyed" {
+					return secretMetadata, secretMetadata.StateDescription, fmt.Errorf("The secret %s was destroyed during rotation", secretId)
+				}
+				return secretMetadata, secretMetadata.StateDescription, nil
+			}
+
+			currentVersionIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+			}
+			currentVersion, err := smCommonFieldsOf(currentVersionIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if currentVersion.ID != versionId {
+				return currentVersion, "rotating", nil
+			}
+			return currentVersion, "active", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIbmSmSecretVersionRotateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 4 {
+		return diag.Errorf("Wrong format of resource ID. To import a secret version rotation use the format `<region>/<instance_id>/<secret_id>/<version_id>`")
+	}
+	region := id[0]
+	instanceId := id[1]
+	secretId := id[2]
+	versionId := id[3]
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d))
+
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(secretId)
+	getSecretVersionMetadataOptions.SetID(versionId)
+
+	secretVersionMetadataIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response))
+	}
+	secretVersionMetadata, err := smCommonFieldsOf(secretVersionMetadataIntf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("region", region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_id: %s", err))
+	}
+	if err = d.Set("secret_type", secretVersionMetadata.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("version_id", versionId); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("created_at", secretVersionMetadata.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+
+	return nil
+}
+
+// The arguments of the rotation force a new rotation, only the endpoint_type of the instance can be updated in place
+func resourceIbmSmSecretVersionRotateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIbmSmSecretVersionRotateRead(context, d, meta)
+}
+
+func resourceIbmSmSecretVersionRotateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Secret versions can't be deleted, the rotation is only removed from the state
+	d.SetId("")
+	return nil
+}
+
+func resourceIbmSmSecretVersionRotateMapToSecretVersionPrototype(d *schema.ResourceData) (secretsmanagerv2.SecretVersionPrototypeIntf, error) {
+	var versionCustomMetadata map[string]interface{}
+	if v, ok := d.GetOk("version_custom_metadata"); ok {
+		versionCustomMetadata = v.(map[string]interface{})
+	}
+
+	switch secretType := d.Get("secret_type").(string); secretType {
+	case ArbitrarySecretType:
+		payload, ok := d.GetOk("payload")
+		if !ok {
+			return nil, fmt.Errorf("\"payload\" is required to rotate a secret of type %s", secretType)
+		}
+		return &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload:               core.StringPtr(payload.(string)),
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case KvSecretType:
+		data, ok := d.GetOk("data")
+		if !ok {
+			return nil, fmt.Errorf("\"data\" is required to rotate a secret of type %s", secretType)
+		}
+		return &secretsmanagerv2.KVSecretVersionPrototype{
+			Data:                  data.(map[string]interface{}),
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case UsernamePasswordSecretType:
+		model := &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{
+			VersionCustomMetadata: versionCustomMetadata,
+		}
+		if password, ok := d.GetOk("password"); ok {
+			model.Password = core.StringPtr(password.(string))
+		}
+		return model, nil
+	case IAMCredentialsSecretType:
+		return &secretsmanagerv2.IAMCredentialsSecretVersionPrototype{
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	case ServiceCredentialsSecretType:
+		return &secretsmanagerv2.ServiceCredentialsSecretVersionPrototype{
+			VersionCustomMetadata: versionCustomMetadata,
+		}, nil
+	default:
+		return nil, fmt.Errorf("Rotation of secrets of type %s is not supported", secretType)
+	}
+}
+
+// smCommonFields holds the fields shared by all secret and secret version models
+type smCommonFields struct {
+	ID               string `json:"id"`
+	SecretType       string `json:"secret_type"`
+	StateDescription string `json:"state_description"`
+	CreatedAt        string `json:"created_at"`
+}
+
+func smCommonFieldsOf(model interface{}) (smCommonFields, error) {
+	var fields smCommonFields
+	jsonData, err := json.Marshal(model)
+	if err == nil {
+		err = json.Unmarshal(jsonData, &fields)
+	}
+	return fields, err
+}
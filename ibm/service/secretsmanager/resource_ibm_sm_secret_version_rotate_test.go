@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmSecretVersionRotateBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_version_rotate.sm_secret_version_rotate"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: secretVersionRotateConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "secret_type", "arbitrary"),
+					testAccCheckIbmSmSecretVersionRotated("ibm_sm_arbitrary_secret.sm_arbitrary_secret_rotated", modifiedPayload),
+				),
+			},
+			{
+				Config: secretVersionRotateConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
+var secretVersionRotateConfigFormat = `
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_rotated" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			name = "%[3]s"
+  			payload = "%[4]s"
+			lifecycle {
+				ignore_changes = [payload]
+			}
+		}
+
+		resource "ibm_sm_secret_version_rotate" "sm_secret_version_rotate" {
+			instance_id   = "%[1]s"
+  			region        = "%[2]s"
+			secret_id     = ibm_sm_arbitrary_secret.sm_arbitrary_secret_rotated.secret_id
+			secret_type   = "arbitrary"
+			payload       = "%[5]s"
+			triggers = {
+				rotation = "%[6]s"
+			}
+		}`
+
+func secretVersionRotateConfig(rotation string) string {
+	return fmt.Sprintf(secretVersionRotateConfigFormat, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion,
+		arbitrarySecretName+"-rotated", payload, modifiedPayload, rotation)
+}
+
+func testAccCheckIbmSmSecretVersionRotated(n, expectedPayload string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arbitrarySecretIntf, err := getSecret(s, n)
+		if err != nil {
+			return err
+		}
+		secret := arbitrarySecretIntf.(*secretsmanagerv2.ArbitrarySecret)
+		if err := verifyAttr(*secret.Payload, expectedPayload, "payload after rotation"); err != nil {
+			return err
+		}
+		if *secret.VersionsTotal < 2 {
+			return fmt.Errorf("Expected at least 2 versions after rotation, got %d", *secret.VersionsTotal)
+		}
+		return nil
+	}
+}
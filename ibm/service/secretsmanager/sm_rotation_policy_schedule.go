@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	smRotationPolicyUnitDay   = "day"
+	smRotationPolicyUnitMonth = "month"
+)
+
+// smRotationPolicyAdd returns the time that is count intervals after anchor
+func smRotationPolicyAdd(anchor time.Time, count, interval int, unit string) time.Time {
+	if unit == smRotationPolicyUnitMonth {
+		return anchor.AddDate(0, count*interval, 0)
+	}
+	return anchor.AddDate(0, 0, count*interval)
+}
+
+// smRotationPolicySchedule returns the number of rotation intervals that elapsed between anchor and now, and the date
+// of the next rotation. The index only changes when a rotation is due, so it can be used as a rotation trigger.
+func smRotationPolicySchedule(anchor, now time.Time, interval int, unit string) (int, time.Time, error) {
+	if interval < 1 {
+		return 0, time.Time{}, fmt.Errorf("the rotation interval must be at least 1, got %d", interval)
+	}
+	if unit != smRotationPolicyUnitDay && unit != smRotationPolicyUnitMonth {
+		return 0, time.Time{}, fmt.Errorf("the rotation unit must be %q or %q, got %q", smRotationPolicyUnitDay, smRotationPolicyUnitMonth, unit)
+	}
+	if now.Before(anchor) {
+		return 0, smRotationPolicyAdd(anchor, 1, interval, unit), nil
+	}
+
+	var index int
+	if unit == smRotationPolicyUnitMonth {
+		months := (now.Year()-anchor.Year())*12 + int(now.Month()) - int(anchor.Month())
+		index = months / interval
+	} else {
+		index = int(now.Sub(anchor) / (time.Duration(interval) * 24 * time.Hour))
+	}
+	// Day lengths and month lengths vary, correct the estimate against the calendar
+	for index > 0 && smRotationPolicyAdd(anchor, index, interval, unit).After(now) {
+		index--
+	}
+	for !smRotationPolicyAdd(anchor, index+1, interval, unit).After(now) {
+		index++
+	}
+	return index, smRotationPolicyAdd(anchor, index+1, interval, unit), nil
+}
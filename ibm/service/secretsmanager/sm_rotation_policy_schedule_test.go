@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmRotationPolicySchedule(t *testing.T) {
+	anchor := time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		now      time.Time
+		interval int
+		unit     string
+		index    int
+		next     time.Time
+	}{
+		{
+			name:     "before the first rotation",
+			now:      anchor.Add(time.Hour),
+			interval: 30,
+			unit:     "day",
+			index:    0,
+			next:     time.Date(2024, time.February, 14, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "exactly on a rotation",
+			now:      time.Date(2024, time.February, 14, 10, 0, 0, 0, time.UTC),
+			interval: 30,
+			unit:     "day",
+			index:    1,
+			next:     time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "several day intervals elapsed",
+			now:      time.Date(2024, time.April, 20, 0, 0, 0, 0, time.UTC),
+			interval: 30,
+			unit:     "day",
+			index:    3,
+			next:     time.Date(2024, time.May, 14, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "month interval not yet elapsed in the next month",
+			now:      time.Date(2024, time.February, 15, 9, 0, 0, 0, time.UTC),
+			interval: 1,
+			unit:     "month",
+			index:    0,
+			next:     time.Date(2024, time.February, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "month intervals across a year",
+			now:      time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			interval: 3,
+			unit:     "month",
+			index:    4,
+			next:     time.Date(2025, time.April, 15, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "clock before the anchor",
+			now:      anchor.Add(-time.Hour),
+			interval: 1,
+			unit:     "month",
+			index:    0,
+			next:     time.Date(2024, time.February, 15, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			index, next, err := smRotationPolicySchedule(anchor, tc.now, tc.interval, tc.unit)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.index, index)
+			assert.Equal(t, tc.next, next)
+		})
+	}
+}
+
+func TestSmRotationPolicyScheduleInvalid(t *testing.T) {
+	anchor := time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	_, _, err := smRotationPolicySchedule(anchor, anchor, 0, "day")
+	assert.Error(t, err)
+	_, _, err = smRotationPolicySchedule(anchor, anchor, 1, "week")
+	assert.Error(t, err)
+}
@@ -29,32 +29,38 @@ const (
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
-	_, ok := d.GetOk("region")
-	if ok {
-		return d.Get("region").(string)
-	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+	region, _ := d.Get("region").(string)
+	return instanceRegion(originalClient, region)
+}
+
+// Return the given region, or the region of the base URL (provider config) when it is empty
+func instanceRegion(originalClient *secretsmanagerv2.SecretsManagerV2, region string) string {
+	if region != "" {
+		return region
 	}
+	// extract region from base URL (provider config)
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
 }
 
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
-	_, ok := d.GetOk("endpoint_type")
-	if ok {
-		return d.Get("endpoint_type").(string)
-	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+	endpointType, _ := d.Get("endpoint_type").(string)
+	return instanceEndpointType(originalClient, endpointType)
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Return the given endpoint type, or the endpoint type of the base URL (provider config) when it is empty
+func instanceEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, endpointType string) string {
+	if endpointType != "" {
+		return endpointType
+	}
+	baseUrl := originalClient.Service.GetServiceURL()
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
 	}
+	return "public"
 }
 
 // Clone the base secrets manager client and set the API endpoint per the instance
@@ -79,6 +85,12 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
+// GetClientWithInstanceEndpoint clones the base secrets manager client for the API endpoint of an instance. The region
+// and endpoint type default to the ones of the provider configuration
+func GetClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId string, region string, endpointType string) *secretsmanagerv2.SecretsManagerV2 {
+	return getClientWithInstanceEndpoint(originalClient, instanceId, instanceRegion(originalClient, region), instanceEndpointType(originalClient, endpointType))
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{